@@ -18,7 +18,11 @@
 // for the terminology.
 package api
 
-import "github.com/square/metrics/inspect"
+import (
+	"time"
+
+	"github.com/square/metrics/inspect"
+)
 
 // API is the set of public methods exposed by the indexer library.
 type API interface {
@@ -36,8 +40,22 @@ type API interface {
 	// using the configured rules. May error out.
 	ToTaggedName(metric GraphiteMetric) (TaggedMetric, error)
 
+	// AddMetrics adds several metrics at once. The returned slice holds
+	// the error for each metric, which is nil if the metric was added.
+	AddMetrics(metrics []TaggedMetric) []error
+
+	// RemoveMetrics removes several metrics at once. The returned slice holds
+	// the error for each metric, which is nil if the metric was removed.
+	RemoveMetrics(metrics []TaggedMetric) []error
+
 	// For a given MetricKey, retrieve all the tagsets associated with it.
-	GetAllTags(metricKey MetricKey) ([]TagSet, error)
+	// If activeSince is non-zero, tagsets last seen before it are omitted.
+	GetAllTags(metricKey MetricKey, activeSince time.Time) ([]TagSet, error)
+
+	// GetMatchingTags returns the tagsets of the given metric which satisfy the predicate.
+	// Implementations may use the predicate's constraints to avoid loading every tagset.
+	// If activeSince is non-zero, tagsets last seen before it are omitted.
+	GetMatchingTags(metricKey MetricKey, predicate Predicate, activeSince time.Time) ([]TagSet, error)
 
 	// GetAllMetrics returns all metrics managed by the system.
 	GetAllMetrics() ([]MetricKey, error)
@@ -45,6 +63,16 @@ type API interface {
 	// For a given tag key-value pair, obtain the list of all the MetricKeys
 	// associated with them.
 	GetMetricsForTag(tagKey, tagValue string) ([]MetricKey, error)
+
+	// GetAllTagKeys returns all tag keys used by any metric.
+	GetAllTagKeys() ([]string, error)
+
+	// GetTagValues returns all values taken by the given tag key across all metrics.
+	GetTagValues(tagKey string) ([]string, error)
+
+	// SearchMetrics returns up to limit metrics whose names contain the query.
+	// Metrics whose names start with the query are listed first.
+	SearchMetrics(query string, limit int) ([]MetricKey, error)
 }
 
 // Configuration is the struct that tells how to instantiate a new copy of an API.
@@ -56,6 +84,14 @@ type Config struct {
 	// https://github.com/gocql/gocql/blob/master/cluster.go
 	Hosts    []string `yaml:"hosts"`
 	Keyspace string   `yaml:"keyspace"`
+
+	// Cardinality limits enforced when metrics are added. Zero means unlimited.
+	MaxTagSetsPerMetric int `yaml:"max_tagsets_per_metric"` // Maximum number of tagsets of a single metric.
+	MaxValuesPerTagKey  int `yaml:"max_values_per_tag_key"` // Maximum number of distinct values of a single tag key.
+
+	// If true, the tagsets matching `=` and `in` predicates are read from tag_set_index.
+	// It should only be enabled once tag_set_index has been filled by the migration.
+	UseTagSetIndex bool `yaml:"use_tag_set_index"`
 }
 
 // ProfilingAPI wraps an ordinary API and also records profiling metrics to a given Profiler object.
@@ -72,6 +108,14 @@ func (api ProfilingAPI) RemoveMetric(metric TaggedMetric) error {
 	defer api.Profiler.Record("api.RemoveMetric")()
 	return api.API.RemoveMetric(metric)
 }
+func (api ProfilingAPI) AddMetrics(metrics []TaggedMetric) []error {
+	defer api.Profiler.Record("api.AddMetrics")()
+	return api.API.AddMetrics(metrics)
+}
+func (api ProfilingAPI) RemoveMetrics(metrics []TaggedMetric) []error {
+	defer api.Profiler.Record("api.RemoveMetrics")()
+	return api.API.RemoveMetrics(metrics)
+}
 func (api ProfilingAPI) ToGraphiteName(metric TaggedMetric) (GraphiteMetric, error) {
 	defer api.Profiler.Record("api.ToGraphiteName")()
 	return api.API.ToGraphiteName(metric)
@@ -80,9 +124,13 @@ func (api ProfilingAPI) ToTaggedName(metric GraphiteMetric) (TaggedMetric, error
 	defer api.Profiler.Record("api.ToTaggedName")()
 	return api.API.ToTaggedName(metric)
 }
-func (api ProfilingAPI) GetAllTags(metricKey MetricKey) ([]TagSet, error) {
+func (api ProfilingAPI) GetAllTags(metricKey MetricKey, activeSince time.Time) ([]TagSet, error) {
 	defer api.Profiler.Record("api.GetAllTags")()
-	return api.API.GetAllTags(metricKey)
+	return api.API.GetAllTags(metricKey, activeSince)
+}
+func (api ProfilingAPI) GetMatchingTags(metricKey MetricKey, predicate Predicate, activeSince time.Time) ([]TagSet, error) {
+	defer api.Profiler.Record("api.GetMatchingTags")()
+	return api.API.GetMatchingTags(metricKey, predicate, activeSince)
 }
 func (api ProfilingAPI) GetAllMetrics() ([]MetricKey, error) {
 	defer api.Profiler.Record("api.GetAllMetrics")()
@@ -92,3 +140,15 @@ func (api ProfilingAPI) GetMetricsForTag(tagKey, tagValue string) ([]MetricKey,
 	defer api.Profiler.Record("api.GetMetricsForTag")()
 	return api.API.GetMetricsForTag(tagKey, tagValue)
 }
+func (api ProfilingAPI) GetAllTagKeys() ([]string, error) {
+	defer api.Profiler.Record("api.GetAllTagKeys")()
+	return api.API.GetAllTagKeys()
+}
+func (api ProfilingAPI) GetTagValues(tagKey string) ([]string, error) {
+	defer api.Profiler.Record("api.GetTagValues")()
+	return api.API.GetTagValues(tagKey)
+}
+func (api ProfilingAPI) SearchMetrics(query string, limit int) ([]MetricKey, error) {
+	defer api.Profiler.Record("api.SearchMetrics")()
+	return api.API.SearchMetrics(query, limit)
+}
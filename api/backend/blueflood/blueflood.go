@@ -147,7 +147,7 @@ func (b *blueflood) FetchSingleSeries(request api.FetchSeriesRequest) (api.Times
 func (b *blueflood) constructURL(request api.FetchSeriesRequest, sampler sampler) (*url.URL, error) {
 	graphiteName, err := request.API.ToGraphiteName(request.Metric)
 	if err != nil {
-		return nil, api.BackendError{request.Metric, api.InvalidSeriesError, "cannot convert to graphite name"}
+		return nil, api.BackendError{request.Metric, api.InvalidSeriesError, "cannot convert to graphite name: " + err.Error()}
 	}
 
 	result, err := url.Parse(fmt.Sprintf("%s/v2.0/%s/views/%s", b.config.BaseUrl, b.config.TenantId, graphiteName))
@@ -35,3 +35,13 @@ type constantPredicate struct {
 func (p constantPredicate) Apply(TagSet) bool {
 	return p.value
 }
+
+// IndexablePredicate is a predicate which requires particular tag values,
+// so that implementations of the API can use an index to narrow down lookups.
+type IndexablePredicate interface {
+	Predicate
+	// Constraints maps tag keys to the values they may take. Every tagset satisfying
+	// the predicate has one of the listed values for each key in the map.
+	// Tagsets satisfying the constraints may still fail the predicate.
+	Constraints() map[string][]string
+}
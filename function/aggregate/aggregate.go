@@ -40,14 +40,13 @@ func groupAccepts(left api.TagSet, right api.TagSet, tags []string) bool {
 }
 
 // addToGroup adds the series to the corresponding bucket, possibly modifying the input `rows` and returning a new list.
+// The series keeps its own TagSet; the group's TagSet holds only the values of `tags`.
 func addToGroup(rows []group, series api.Timeseries, tags []string) []group {
-	// First we delete all tags with names other than those found in 'tags'
+	// The group's tags are those tags with names found in 'tags'
 	newTags := api.NewTagSet()
 	for _, tag := range tags {
 		newTags[tag] = series.TagSet[tag]
 	}
-	// replace series' TagSet with newTags
-	series.TagSet = newTags
 
 	// Next, find the best bucket for this series:
 	for i, row := range rows {
@@ -72,6 +71,23 @@ func groupBy(list api.SeriesList, tags []string) []group {
 	return result
 }
 
+// GroupBy partitions the given SeriesList into groups of series which agree on the values of all `tags`.
+// It returns, for each group, the TagSet holding those values and the SeriesList of its members (with their original TagSets).
+func GroupBy(list api.SeriesList, tags []string) ([]api.TagSet, []api.SeriesList) {
+	groups := groupBy(list, tags)
+	tagSets := make([]api.TagSet, len(groups))
+	lists := make([]api.SeriesList, len(groups))
+	for i, group := range groups {
+		tagSets[i] = group.TagSet
+		lists[i] = api.SeriesList{
+			Series:    group.List,
+			Timerange: list.Timerange,
+			Name:      list.Name,
+		}
+	}
+	return tagSets, lists
+}
+
 // filterNaN removes NaN elements from the given slice (producing a copy)
 func filterNaN(array []float64) []float64 {
 	result := []float64{}
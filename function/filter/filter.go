@@ -19,6 +19,7 @@ import (
 	"sort"
 
 	"github.com/square/metrics/api"
+	"github.com/square/metrics/function/aggregate"
 )
 
 type filterList struct {
@@ -54,13 +55,9 @@ func newFilterList(size int, ascending bool) filterList {
 	}
 }
 
-// FilteryBy reduces the number of things in the series `list` to at most the given `count`.
-// They're chosen by sorting by `summary` in `ascending` or descending order.
-func FilterBy(list api.SeriesList, count int, summary func([]float64) float64, lowest bool) api.SeriesList {
-	if len(list.Series) < count {
-		// No need to change if there's already fewer.
-		return list
-	}
+// sortBy returns the series in `list` sorted by `summary` in ascending (`lowest`) or descending order.
+// Series whose summary is NaN are placed last.
+func sortBy(list api.SeriesList, summary func([]float64) float64, lowest bool) []api.Timeseries {
 	array := newFilterList(len(list.Series), lowest)
 	for i := range array.index {
 		array.index[i] = i
@@ -68,13 +65,86 @@ func FilterBy(list api.SeriesList, count int, summary func([]float64) float64, l
 	}
 	sort.Sort(array)
 
-	series := make([]api.Timeseries, count)
+	series := make([]api.Timeseries, len(list.Series))
 	for i := range series {
 		series[i] = list.Series[array.index[i]]
 	}
+	return series
+}
+
+// FilteryBy reduces the number of things in the series `list` to at most the given `count`.
+// They're chosen by sorting by `summary` in `ascending` or descending order.
+func FilterBy(list api.SeriesList, count int, summary func([]float64) float64, lowest bool) api.SeriesList {
+	if len(list.Series) < count {
+		// No need to change if there's already fewer.
+		return list
+	}
+	return api.SeriesList{
+		Series:    sortBy(list, summary, lowest)[:count],
+		Timerange: list.Timerange,
+	}
+}
+
+// FilterGroupsBy is like FilterBy, but reduces each group of series sharing the values of `tags` to at most `count`
+// (the groups are formed in the same way as for aggregation). If `others` is true, then for each group with dropped series,
+// an additional series is included which is the sum of the dropped series, and whose TagSet has only the group's tags.
+func FilterGroupsBy(list api.SeriesList, tags []string, count int, summary func([]float64) float64, lowest bool, others bool) api.SeriesList {
+	result := api.SeriesList{
+		Series:    []api.Timeseries{},
+		Timerange: list.Timerange,
+	}
+	_, groups := aggregate.GroupBy(list, tags)
+	for _, group := range groups {
+		if len(group.Series) <= count {
+			result.Series = append(result.Series, group.Series...)
+			continue
+		}
+		sorted := sortBy(group, summary, lowest)
+		result.Series = append(result.Series, sorted[:count]...)
+		if others {
+			dropped := api.SeriesList{
+				Series:    sorted[count:],
+				Timerange: list.Timerange,
+			}
+			result.Series = append(result.Series, aggregate.AggregateBy(dropped, aggregate.Sum, tags).Series...)
+		}
+	}
+	return result
+}
 
+// FilterThreshold keeps only the series in `list` whose values satisfy `keep` against `threshold`.
+// The order of the remaining series is preserved.
+func FilterThreshold(list api.SeriesList, threshold float64, keep func([]float64, float64) bool) api.SeriesList {
+	series := []api.Timeseries{}
+	for _, s := range list.Series {
+		if keep(s.Values, threshold) {
+			series = append(series, s)
+		}
+	}
 	return api.SeriesList{
 		Series:    series,
 		Timerange: list.Timerange,
 	}
 }
+
+// AnyAbove returns true if at least one value is strictly greater than `threshold`.
+// NaN values are ignored.
+func AnyAbove(values []float64, threshold float64) bool {
+	for _, value := range values {
+		if value > threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// AllBelow returns true if every value is strictly less than `threshold`.
+// NaN values are ignored.
+func AllBelow(values []float64, threshold float64) bool {
+	for _, value := range values {
+		if value >= threshold {
+			return false
+		}
+	}
+	return true
+}
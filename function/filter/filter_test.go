@@ -15,6 +15,7 @@
 package filter
 
 import (
+	"math"
 	"testing"
 
 	"github.com/square/metrics/api"
@@ -181,3 +182,95 @@ func TestFilter(t *testing.T) {
 		}
 	}
 }
+
+func TestFilterThreshold(t *testing.T) {
+	a := assert.New(t)
+	timerange, err := api.NewTimerange(1300, 1500, 100)
+	if err != nil {
+		t.Fatalf("invalid timerange used in testcase")
+	}
+	list := api.SeriesList{
+		Series: []api.Timeseries{
+			{Values: []float64{1, 2, 3}, TagSet: api.TagSet{"name": "A"}},
+			{Values: []float64{5, 6, math.NaN()}, TagSet: api.TagSet{"name": "B"}},
+			{Values: []float64{0, 0, 0}, TagSet: api.TagSet{"name": "C"}},
+		},
+		Timerange: timerange,
+	}
+	tests := []struct {
+		keep      func([]float64, float64) bool
+		threshold float64
+		expect    []string
+	}{
+		{AnyAbove, 2, []string{"A", "B"}},
+		{AnyAbove, 5, []string{"B"}},
+		{AnyAbove, 6, []string{}},
+		{AllBelow, 4, []string{"A", "C"}},
+		{AllBelow, 7, []string{"A", "B", "C"}},
+		{AllBelow, 0, []string{}},
+	}
+	for _, test := range tests {
+		filtered := FilterThreshold(list, test.threshold, test.keep)
+		names := []string{}
+		for _, s := range filtered.Series {
+			names = append(names, s.TagSet["name"])
+		}
+		a.Contextf("threshold %f", test.threshold).Eq(names, test.expect)
+	}
+}
+
+func TestFilterGroupsBy(t *testing.T) {
+	timerange, err := api.NewTimerange(1300, 1500, 100)
+	if err != nil {
+		t.Fatalf("invalid timerange used in testcase")
+	}
+	list := api.SeriesList{
+		Series: []api.Timeseries{
+			{Values: []float64{1, 1, 1}, TagSet: api.TagSet{"dc": "east", "host": "a"}},
+			{Values: []float64{3, 3, 3}, TagSet: api.TagSet{"dc": "east", "host": "b"}},
+			{Values: []float64{2, 2, 2}, TagSet: api.TagSet{"dc": "east", "host": "c"}},
+			{Values: []float64{5, 5, 5}, TagSet: api.TagSet{"dc": "west", "host": "d"}},
+		},
+		Timerange: timerange,
+	}
+	tests := []struct {
+		tags   []string
+		others bool
+		expect []api.Timeseries
+	}{
+		{
+			tags:   []string{"dc"},
+			others: false,
+			expect: []api.Timeseries{list.Series[1], list.Series[3]},
+		},
+		{
+			tags:   []string{"dc"},
+			others: true,
+			expect: []api.Timeseries{
+				list.Series[1],
+				{Values: []float64{3, 3, 3}, TagSet: api.TagSet{"dc": "east"}},
+				list.Series[3],
+			},
+		},
+		{
+			tags:   []string{},
+			others: true,
+			expect: []api.Timeseries{
+				list.Series[3],
+				{Values: []float64{6, 6, 6}, TagSet: api.TagSet{}},
+			},
+		},
+	}
+	for _, test := range tests {
+		a := assert.New(t).Contextf("tags %+v, others %t", test.tags, test.others)
+		filtered := FilterGroupsBy(list, test.tags, 1, aggregate.Max, false, test.others)
+		a.EqInt(len(filtered.Series), len(test.expect))
+		if len(filtered.Series) != len(test.expect) {
+			continue
+		}
+		for i := range test.expect {
+			a.Eq(filtered.Series[i].TagSet, test.expect[i].TagSet)
+			a.EqFloatArray(filtered.Series[i].Values, test.expect[i].Values, 1e-7)
+		}
+	}
+}
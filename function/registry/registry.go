@@ -34,6 +34,19 @@ func init() {
 	MustRegister(NewOperator("-", func(x float64, y float64) float64 { return x - y }))
 	MustRegister(NewOperator("*", func(x float64, y float64) float64 { return x * y }))
 	MustRegister(NewOperator("/", func(x float64, y float64) float64 { return x / y }))
+	// Comparison operators
+	MustRegister(NewComparison(">", func(x float64, y float64) bool { return x > y }))
+	MustRegister(NewComparison("<", func(x float64, y float64) bool { return x < y }))
+	MustRegister(NewComparison(">=", func(x float64, y float64) bool { return x >= y }))
+	MustRegister(NewComparison("<=", func(x float64, y float64) bool { return x <= y }))
+	MustRegister(NewComparison("==", func(x float64, y float64) bool { return x == y }))
+	MustRegister(NewComparison("!=", func(x float64, y float64) bool { return x != y }))
+	MustRegister(NewOperator("> bool", boolIf(func(x float64, y float64) bool { return x > y })))
+	MustRegister(NewOperator("< bool", boolIf(func(x float64, y float64) bool { return x < y })))
+	MustRegister(NewOperator(">= bool", boolIf(func(x float64, y float64) bool { return x >= y })))
+	MustRegister(NewOperator("<= bool", boolIf(func(x float64, y float64) bool { return x <= y })))
+	MustRegister(NewOperator("== bool", boolIf(func(x float64, y float64) bool { return x == y })))
+	MustRegister(NewOperator("!= bool", boolIf(func(x float64, y float64) bool { return x != y })))
 	// Aggregates
 	MustRegister(NewAggregate("aggregate.max", aggregate.Max))
 	MustRegister(NewAggregate("aggregate.min", aggregate.Min))
@@ -48,6 +61,18 @@ func init() {
 	MustRegister(NewTransform("transform.abs", 0, transform.MapMaker(math.Abs)))
 	MustRegister(NewTransform("transform.log", 0, transform.MapMaker(math.Log10)))
 	MustRegister(NewTransform("transform.nan_keep_last", 0, transform.NaNKeepLast))
+	MustRegister(NewOptionalTransform("transform.increase", 0, 1, transform.Increase))
+	MustRegister(NewOptionalTransform("transform.counter_rate", 0, 1, transform.CounterRate))
+	// Math
+	MustRegister(NewTransform("math.pow", 1, transform.ParameterizedMapMaker(transform.Pow)))
+	MustRegister(NewTransform("math.sqrt", 0, transform.MapMaker(math.Sqrt)))
+	MustRegister(NewTransform("math.exp", 0, transform.MapMaker(math.Exp)))
+	MustRegister(NewTransform("math.ln", 0, transform.MapMaker(math.Log)))
+	MustRegister(NewTransform("math.log", 1, transform.ParameterizedMapMaker(transform.Log)))
+	MustRegister(NewTransform("math.floor", 0, transform.MapMaker(math.Floor)))
+	MustRegister(NewTransform("math.ceil", 0, transform.MapMaker(math.Ceil)))
+	MustRegister(NewTransform("math.round", 1, transform.ParameterizedMapMaker(transform.Round)))
+	MustRegister(NewTransform("math.clamp", 2, transform.ParameterizedMapMaker(transform.Clamp)))
 	// Filter
 	MustRegister(NewFilter("filter.highest_mean", aggregate.Mean, false))
 	MustRegister(NewFilter("filter.lowest_mean", aggregate.Mean, true))
@@ -55,6 +80,8 @@ func init() {
 	MustRegister(NewFilter("filter.lowest_max", aggregate.Max, true))
 	MustRegister(NewFilter("filter.highest_min", aggregate.Min, false))
 	MustRegister(NewFilter("filter.lowest_min", aggregate.Min, true))
+	MustRegister(NewThresholdFilter("filter.any_above", filter.AnyAbove))
+	MustRegister(NewThresholdFilter("filter.all_below", filter.AllBelow))
 	// Weird ones
 	MustRegister(transform.Timeshift)
 	MustRegister(transform.Alias)
@@ -116,11 +143,13 @@ func MustRegister(fun function.MetricFunction) {
 // Constructor Functions
 
 // NewFilter creates a new instance of a filtering function.
+// The optional third argument 'others' requests a series summing the dropped series in each group.
 func NewFilter(name string, summary func([]float64) float64, ascending bool) function.MetricFunction {
 	return function.MetricFunction{
-		Name:         name,
-		MinArguments: 2,
-		MaxArguments: 2,
+		Name:          name,
+		MinArguments:  2,
+		MaxArguments:  3,
+		AllowsGroupBy: true,
 		Compute: func(context function.EvaluationContext, arguments []function.Expression, groups []string) (function.Value, error) {
 			value, err := arguments[0].Evaluate(context)
 			if err != nil {
@@ -144,8 +173,67 @@ func NewFilter(name string, summary func([]float64) float64, ascending bool) fun
 			if count < 0 {
 				return nil, fmt.Errorf("expected positive count but got %d", count)
 			}
-			result := filter.FilterBy(list, count, summary, ascending)
-			result.Name = fmt.Sprintf("%s(%s, %d)", name, value.GetName(), count)
+			others := false
+			if len(arguments) == 3 {
+				othersValue, err := arguments[2].Evaluate(context)
+				if err != nil {
+					return nil, err
+				}
+				othersString, err := othersValue.ToString()
+				if err != nil {
+					return nil, err
+				}
+				if othersString != "others" {
+					return nil, fmt.Errorf("expected 'others' but got '%s'", othersString)
+				}
+				others = true
+			}
+			var result api.SeriesList
+			if len(groups) == 0 && !others {
+				result = filter.FilterBy(list, count, summary, ascending)
+			} else {
+				result = filter.FilterGroupsBy(list, groups, count, summary, ascending, others)
+			}
+			description := fmt.Sprintf("%s, %d", value.GetName(), count)
+			if others {
+				description += ", others"
+			}
+			if len(groups) != 0 {
+				description += fmt.Sprintf(" group by %s", strings.Join(groups, ", "))
+			}
+			result.Name = fmt.Sprintf("%s(%s)", name, description)
+			return function.SeriesListValue(result), nil
+		},
+	}
+}
+
+// NewThresholdFilter creates a new instance of a filtering function which drops whole series,
+// keeping only those whose values satisfy `keep` against a scalar threshold.
+func NewThresholdFilter(name string, keep func([]float64, float64) bool) function.MetricFunction {
+	return function.MetricFunction{
+		Name:         name,
+		MinArguments: 2,
+		MaxArguments: 2,
+		Compute: func(context function.EvaluationContext, arguments []function.Expression, groups []string) (function.Value, error) {
+			value, err := arguments[0].Evaluate(context)
+			if err != nil {
+				return nil, err
+			}
+			// The value must be a SeriesList.
+			list, err := value.ToSeriesList(context.Timerange)
+			if err != nil {
+				return nil, err
+			}
+			thresholdValue, err := arguments[1].Evaluate(context)
+			if err != nil {
+				return nil, err
+			}
+			threshold, err := thresholdValue.ToScalar()
+			if err != nil {
+				return nil, err
+			}
+			result := filter.FilterThreshold(list, threshold, keep)
+			result.Name = fmt.Sprintf("%s(%s, %g)", name, value.GetName(), threshold)
 			return function.SeriesListValue(result), nil
 		},
 	}
@@ -185,10 +273,16 @@ func NewAggregate(name string, aggregator func([]float64) float64) function.Metr
 
 // NewTransform takes a named transforming function `[float64], [value] => [float64]` and makes it into a MetricFunction.
 func NewTransform(name string, parameterCount int, transformer func([]float64, []function.Value, float64) ([]float64, error)) function.MetricFunction {
+	return NewOptionalTransform(name, parameterCount, parameterCount, transformer)
+}
+
+// NewOptionalTransform is like NewTransform, but allows the transform to take between
+// `minParameters` and `maxParameters` parameters. The transformer receives only the parameters supplied.
+func NewOptionalTransform(name string, minParameters int, maxParameters int, transformer func([]float64, []function.Value, float64) ([]float64, error)) function.MetricFunction {
 	return function.MetricFunction{
 		Name:         name,
-		MinArguments: parameterCount + 1,
-		MaxArguments: parameterCount + 1,
+		MinArguments: minParameters + 1,
+		MaxArguments: maxParameters + 1,
 		Compute: func(context function.EvaluationContext, args []function.Expression, groups []string) (function.Value, error) {
 			listValue, err := args[0].Evaluate(context)
 			if err != nil {
@@ -198,7 +292,7 @@ func NewTransform(name string, parameterCount int, transformer func([]float64, [
 			if err != nil {
 				return nil, err
 			}
-			parameters := make([]function.Value, parameterCount)
+			parameters := make([]function.Value, len(args)-1)
 			for i := range parameters {
 				parameters[i], err = args[i+1].Evaluate(context)
 				if err != nil {
@@ -226,6 +320,26 @@ func NewTransform(name string, parameterCount int, transformer func([]float64, [
 // NewOperator creates a new binary operator function.
 // the binary operators display a natural join semantic.
 func NewOperator(op string, operator func(float64, float64) float64) function.MetricFunction {
+	return newOperator(op, func(function.Value, function.Value) func(float64, float64) float64 {
+		return operator
+	})
+}
+
+// NewComparison creates a comparison operator which keeps the values of its series operand
+// where the comparison holds: the left one, unless only the right one is a series, as in `2 < cpu`.
+func NewComparison(op string, compare func(float64, float64) bool) function.MetricFunction {
+	return newOperator(op, func(leftValue function.Value, rightValue function.Value) func(float64, float64) float64 {
+		_, leftScalar := leftValue.(function.ScalarValue)
+		_, rightScalar := rightValue.(function.ScalarValue)
+		if leftScalar && !rightScalar {
+			return keepRightIf(compare)
+		}
+		return keepIf(compare)
+	})
+}
+
+// newOperator creates a binary operator, whose operation may depend on the values of its operands.
+func newOperator(op string, choose func(function.Value, function.Value) func(float64, float64) float64) function.MetricFunction {
 	return function.MetricFunction{
 		Name:         op,
 		MinArguments: 2,
@@ -264,6 +378,7 @@ func NewOperator(op string, operator func(float64, float64) float64) function.Me
 				return nil, err
 			}
 
+			operator := choose(leftValue, rightValue)
 			joined := join.Join([]api.SeriesList{leftList, rightList})
 
 			result := make([]api.Timeseries, len(joined.Rows))
@@ -286,3 +401,39 @@ func NewOperator(op string, operator func(float64, float64) float64) function.Me
 		},
 	}
 }
+
+// keepIf converts a comparison into an operator which keeps the left value
+// where the comparison holds, and produces NaN everywhere else.
+func keepIf(compare func(float64, float64) bool) func(float64, float64) float64 {
+	return func(x float64, y float64) float64 {
+		if compare(x, y) {
+			return x
+		}
+		return math.NaN()
+	}
+}
+
+// keepRightIf converts a comparison into an operator which keeps the right value
+// where the comparison holds, and produces NaN everywhere else.
+func keepRightIf(compare func(float64, float64) bool) func(float64, float64) float64 {
+	return func(x float64, y float64) float64 {
+		if compare(x, y) {
+			return y
+		}
+		return math.NaN()
+	}
+}
+
+// boolIf converts a comparison into an operator which produces 1 where the
+// comparison holds and 0 where it does not. Missing (NaN) values stay NaN.
+func boolIf(compare func(float64, float64) bool) func(float64, float64) float64 {
+	return func(x float64, y float64) float64 {
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return math.NaN()
+		}
+		if compare(x, y) {
+			return 1
+		}
+		return 0
+	}
+}
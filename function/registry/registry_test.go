@@ -16,6 +16,7 @@ package registry
 
 import (
 	"errors"
+	"math"
 	"testing"
 
 	"github.com/square/metrics/assert"
@@ -60,3 +61,26 @@ func Test_Registry_Error(t *testing.T) {
 		}
 	}
 }
+
+func Test_Comparison(t *testing.T) {
+	greater := func(x float64, y float64) bool { return x > y }
+	nan := math.NaN()
+	for _, test := range []struct {
+		left      float64
+		right     float64
+		keep      float64
+		keepRight float64
+		boolean   float64
+	}{
+		{3, 2, 3, 2, 1},
+		{2, 3, nan, nan, 0},
+		{2, 2, nan, nan, 0},
+		{nan, 2, nan, nan, nan},
+		{2, nan, nan, nan, nan},
+	} {
+		a := assert.New(t).Contextf("%f > %f", test.left, test.right)
+		a.EqFloat(keepIf(greater)(test.left, test.right), test.keep, 1e-10)
+		a.EqFloat(keepRightIf(greater)(test.left, test.right), test.keepRight, 1e-10)
+		a.EqFloat(boolIf(greater)(test.left, test.right), test.boolean, 1e-10)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"fmt"
+	"math"
+)
+
+// Parameterized math functions, for use with ParameterizedMapMaker.
+
+// Pow raises each value to the power given by the first parameter.
+func Pow(parameters []float64) (func(float64) float64, error) {
+	exponent := parameters[0]
+	return func(x float64) float64 {
+		return math.Pow(x, exponent)
+	}, nil
+}
+
+// Log takes the logarithm of each value in the base given by the first parameter.
+func Log(parameters []float64) (func(float64) float64, error) {
+	base := parameters[0]
+	if base <= 0 || base == 1 {
+		return nil, fmt.Errorf("invalid logarithm base %g", base)
+	}
+	denominator := math.Log(base)
+	return func(x float64) float64 {
+		return math.Log(x) / denominator
+	}, nil
+}
+
+// Round rounds each value (half away from zero) to the number of decimal places given by the first parameter.
+// A negative precision rounds to tens, hundreds, and so on.
+func Round(parameters []float64) (func(float64) float64, error) {
+	precision := parameters[0]
+	if precision != math.Trunc(precision) {
+		return nil, fmt.Errorf("expected integer precision but got %g", precision)
+	}
+	factor := math.Pow(10, precision)
+	return func(x float64) float64 {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return x
+		}
+		if x < 0 {
+			return -math.Floor(-x*factor+0.5) / factor
+		}
+		return math.Floor(x*factor+0.5) / factor
+	}, nil
+}
+
+// Clamp bounds each value to lie between the first and second parameters (inclusive).
+// Missing (NaN) values remain NaN.
+func Clamp(parameters []float64) (func(float64) float64, error) {
+	low, high := parameters[0], parameters[1]
+	if low > high {
+		return nil, fmt.Errorf("clamp lower bound %g exceeds upper bound %g", low, high)
+	}
+	return func(x float64) float64 {
+		if x < low {
+			return low
+		}
+		if x > high {
+			return high
+		}
+		return x
+	}, nil
+}
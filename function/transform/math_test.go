@@ -0,0 +1,58 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transform
+
+import (
+	"math"
+	"testing"
+
+	"github.com/square/metrics/assert"
+	"github.com/square/metrics/function"
+)
+
+func TestParameterizedMapMaker(t *testing.T) {
+	nan := math.NaN()
+	values := []float64{-2.55, 0.1, 1, 2.5, 100, nan}
+	for _, test := range []struct {
+		name       string
+		build      func([]float64) (func(float64) float64, error)
+		parameters []function.Value
+		expected   []float64
+		expectErr  bool
+	}{
+		{"pow", Pow, []function.Value{function.ScalarValue(2)}, []float64{6.5025, 0.01, 1, 6.25, 10000, nan}, false},
+		{"log", Log, []function.Value{function.ScalarValue(10)}, []float64{nan, -1, 0, math.Log10(2.5), 2, nan}, false},
+		{"log base 1", Log, []function.Value{function.ScalarValue(1)}, nil, true},
+		{"log base 0", Log, []function.Value{function.ScalarValue(0)}, nil, true},
+		{"round 0", Round, []function.Value{function.ScalarValue(0)}, []float64{-3, 0, 1, 3, 100, nan}, false},
+		{"round 1", Round, []function.Value{function.ScalarValue(1)}, []float64{-2.6, 0.1, 1, 2.5, 100, nan}, false},
+		{"round -2", Round, []function.Value{function.ScalarValue(-2)}, []float64{0, 0, 0, 0, 100, nan}, false},
+		{"round fractional", Round, []function.Value{function.ScalarValue(0.5)}, nil, true},
+		{"clamp", Clamp, []function.Value{function.ScalarValue(0), function.ScalarValue(2)}, []float64{0, 0.1, 1, 2, 2, nan}, false},
+		{"clamp inverted", Clamp, []function.Value{function.ScalarValue(2), function.ScalarValue(0)}, nil, true},
+		{"non-scalar", Pow, []function.Value{function.StringValue("x")}, nil, true},
+	} {
+		a := assert.New(t).Contextf("%s", test.name)
+		result, err := ParameterizedMapMaker(test.build)(values, test.parameters, 1)
+		if test.expectErr {
+			if err == nil {
+				a.Errorf("expected an error but got none")
+			}
+			continue
+		}
+		a.CheckError(err)
+		a.EqFloatArray(result, test.expected, 1e-10)
+	}
+}
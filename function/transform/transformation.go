@@ -102,6 +102,66 @@ func Rate(values []float64, parameters []function.Value, scale float64) ([]float
 	return result, nil
 }
 
+// Increase computes the consecutive increase of a monotonic counter, accounting for counter resets.
+// When a value is smaller than the one before it, the counter is assumed to have been reset to zero,
+// so the new value itself is the increase. If a maximum value is supplied as an optional parameter,
+// a decrease is instead treated as the counter wrapping around from the maximum back to zero, which
+// takes one increment. A real reset of such a counter is then reported as an increase close to the maximum.
+// Missing (NaN) values remain missing, but the following value is compared against the last known value.
+func Increase(values []float64, parameters []function.Value, scale float64) ([]float64, error) {
+	maxValue := math.NaN()
+	if len(parameters) > 0 {
+		var err error
+		maxValue, err = parameters[0].ToScalar()
+		if err != nil {
+			return nil, err
+		}
+	}
+	result := make([]float64, len(values))
+	last := math.NaN()
+	for i := range values {
+		current := values[i]
+		switch {
+		case math.IsNaN(current):
+			result[i] = math.NaN()
+			continue
+		case math.IsNaN(last):
+			// The first known value has nothing to compare against.
+			result[i] = 0
+		case current >= last:
+			result[i] = current - last
+		case !math.IsNaN(maxValue) && last <= maxValue:
+			// The counter wrapped around, from maxValue to 0 in one increment.
+			result[i] = maxValue - last + 1 + current
+		default:
+			// The counter was reset.
+			result[i] = current
+		}
+		last = current
+	}
+	return result, nil
+}
+
+// CounterRate computes the "change per second" of a monotonic counter, accounting for counter resets.
+// It takes the same optional maximum value parameter as Increase.
+// After missing (NaN) values, the increase since the last known value is spread over the whole gap.
+func CounterRate(values []float64, parameters []function.Value, scale float64) ([]float64, error) {
+	result, err := Increase(values, parameters, scale)
+	if err != nil {
+		return nil, err
+	}
+	intervals := 1 // since the last known value
+	for i := range result {
+		if math.IsNaN(values[i]) {
+			intervals++
+			continue
+		}
+		result[i] /= scale * float64(intervals)
+		intervals = 1
+	}
+	return result, nil
+}
+
 // Cumulative computes the cumulative sum of the given values.
 func Cumulative(values []float64, parameters []function.Value, scale float64) ([]float64, error) {
 	result := make([]float64, len(values))
@@ -128,6 +188,27 @@ func MapMaker(fun func(float64) float64) func([]float64, []function.Value, float
 	}
 }
 
+// ParameterizedMapMaker generalizes MapMaker to functions which take scalar parameters, such as `math.pow`.
+// The parameters are converted to scalars and passed to `build`, which returns the function to apply to each value.
+// `build` may reject invalid parameters by returning an error.
+func ParameterizedMapMaker(build func([]float64) (func(float64) float64, error)) func([]float64, []function.Value, float64) ([]float64, error) {
+	return func(values []float64, parameters []function.Value, scale float64) ([]float64, error) {
+		scalars := make([]float64, len(parameters))
+		for i := range parameters {
+			scalar, err := parameters[i].ToScalar()
+			if err != nil {
+				return nil, err
+			}
+			scalars[i] = scalar
+		}
+		fun, err := build(scalars)
+		if err != nil {
+			return nil, err
+		}
+		return MapMaker(fun)(values, nil, scale)
+	}
+}
+
 // Default will replacing missing data (NaN) with the `default` value supplied as a parameter.
 func Default(values []float64, parameters []function.Value, scale float64) ([]float64, error) {
 	defaultValue, err := parameters[0].ToScalar()
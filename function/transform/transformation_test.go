@@ -269,6 +269,33 @@ func TestApplyTransformNaN(t *testing.T) {
 				"C": {0, 1 / 30.0, 1 / 30.0, nan, nan, 0},
 			},
 		},
+		{
+			transform:  Increase,
+			parameters: []function.Value{},
+			expected: map[string][]float64{
+				"A": {0, 1, nan, 2, 1, 1},
+				"B": {0, nan, nan, nan, 1, 0},
+				"C": {0, 1, 1, nan, 0, 1},
+			},
+		},
+		{
+			transform:  Increase,
+			parameters: []function.Value{function.ScalarValue(10)},
+			expected: map[string][]float64{
+				"A": {0, 1, nan, 2, 1, 1},
+				"B": {0, nan, nan, nan, 1, 0},
+				"C": {0, 1, 1, nan, 0, 10},
+			},
+		},
+		{
+			transform:  CounterRate,
+			parameters: []function.Value{},
+			expected: map[string][]float64{
+				"A": {0, 1 / 30.0, nan, 2 / 60.0, 1 / 30.0, 1 / 30.0},
+				"B": {0, nan, nan, nan, 1 / 120.0, 0},
+				"C": {0, 1 / 30.0, 1 / 30.0, nan, 0, 1 / 30.0},
+			},
+		},
 		{
 			transform:  Cumulative,
 			parameters: []function.Value{},
@@ -321,3 +348,46 @@ func TestApplyTransformNaN(t *testing.T) {
 		}
 	}
 }
+
+func TestIncreaseWrap(t *testing.T) {
+	values := []float64{250, 254, 0, 255, 0, 5, 3}
+	expected := []float64{0, 4, 2, 255, 1, 5, 254}
+	result, err := Increase(values, []function.Value{function.ScalarValue(255)}, 30)
+	if err != nil {
+		t.Fatalf("error computing the increase of %+v: %s", values, err.Error())
+	}
+	for i := range result {
+		if math.Abs(result[i]-expected[i]) > 1e-7 {
+			t.Errorf("increase of %+v: (actual) %+v != %+v (expected)", values, result, expected)
+			break
+		}
+	}
+}
+
+func TestCounterRateGap(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		values     []float64
+		parameters []function.Value
+		expected   []float64
+	}{
+		{[]float64{10, nan, nan, 16, 19}, nil, []float64{0, nan, nan, 6 / 90.0, 3 / 30.0}},
+		{[]float64{10, nan, 4, nan, 8}, nil, []float64{0, nan, 4 / 60.0, nan, 4 / 60.0}},
+		{[]float64{nan, nan, 5, 7}, nil, []float64{nan, nan, 0, 2 / 30.0}},
+		{[]float64{8, nan, 2}, []function.Value{function.ScalarValue(10)}, []float64{0, nan, 5 / 60.0}},
+	}
+	for _, test := range tests {
+		result, err := CounterRate(test.values, test.parameters, 30)
+		if err != nil {
+			t.Fatalf("error computing the counter rate of %+v: %s", test.values, err.Error())
+		}
+		for i := range result {
+			v := result[i]
+			e := test.expected[i]
+			if (math.IsNaN(e) != math.IsNaN(v)) || (!math.IsNaN(e) && math.Abs(v-e) > 1e-7) {
+				t.Errorf("counter rate of %+v: (actual) %+v != %+v (expected)", test.values, result, test.expected)
+				break
+			}
+		}
+	}
+}
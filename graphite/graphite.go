@@ -0,0 +1,87 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphite parses the points sent by carbon relays, in the line and pickle protocols.
+package graphite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/square/metrics/api"
+)
+
+// Point is a single value of a graphite series.
+type Point struct {
+	Name      string     // graphite name, without its tags.
+	Tags      api.TagSet // nil if the series is untagged.
+	Value     float64
+	Timestamp int64 // in seconds since the epoch.
+}
+
+// Matcher converts graphite names to tagged metrics, like internal.RuleSet.
+type Matcher interface {
+	MatchRule(input string) (api.TaggedMetric, bool)
+}
+
+// ParseError is returned when the input is not in the expected format.
+type ParseError struct {
+	Input   string
+	Message string
+}
+
+func (err ParseError) Error() string {
+	return fmt.Sprintf("Cannot parse '%s': %s", err.Input, err.Message)
+}
+
+// TaggedMetric converts the series of the point to a tagged metric.
+// Tagged series are converted directly, and untagged series are converted by the matcher.
+func (point Point) TaggedMetric(matcher Matcher) (api.TaggedMetric, bool) {
+	if point.Tags != nil {
+		return api.TaggedMetric{MetricKey: api.MetricKey(point.Name), TagSet: point.Tags}, true
+	}
+	return matcher.MatchRule(point.Name)
+}
+
+// parseSeries splits a series into its name and tags, as in Graphite 1.1 (`name;tag=value;tag=value`).
+// The tags are nil if there are none.
+func parseSeries(series string) (string, api.TagSet, error) {
+	parts := strings.Split(series, ";")
+	name := parts[0]
+	if name == "" {
+		return "", nil, ParseError{series, "empty name"}
+	}
+	if len(parts) == 1 {
+		return name, nil, nil
+	}
+	tags := api.NewTagSet()
+	for _, part := range parts[1:] {
+		equals := strings.IndexByte(part, '=')
+		if equals < 0 {
+			return "", nil, ParseError{series, fmt.Sprintf("tag '%s' has no value", part)}
+		}
+		key, value := part[:equals], part[equals+1:]
+		if key == "" || strings.ContainsAny(key, "!^") {
+			return "", nil, ParseError{series, fmt.Sprintf("invalid tag key '%s'", key)}
+		}
+		if key == "name" {
+			return "", nil, ParseError{series, "the tag key 'name' is reserved"}
+		}
+		if value == "" || strings.HasPrefix(value, "~") {
+			return "", nil, ParseError{series, fmt.Sprintf("invalid value '%s' of tag '%s'", value, key)}
+		}
+		tags[key] = value
+	}
+	return name, tags, nil
+}
@@ -0,0 +1,43 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphite
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ParseLine parses a point in the line protocol, `series value timestamp`,
+// where the series may carry tags (`name;tag=value`).
+func ParseLine(line string) (Point, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return Point{}, ParseError{line, "expected a series, a value and a timestamp"}
+	}
+	name, tags, err := parseSeries(fields[0])
+	if err != nil {
+		return Point{}, err
+	}
+	value, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return Point{}, ParseError{line, "invalid value"}
+	}
+	// Some clients send fractional timestamps.
+	timestamp, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return Point{}, ParseError{line, "invalid timestamp"}
+	}
+	return Point{Name: name, Tags: tags, Value: value, Timestamp: int64(timestamp)}, nil
+}
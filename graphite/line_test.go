@@ -0,0 +1,82 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphite
+
+import (
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func TestParseLine(t *testing.T) {
+	for _, test := range []struct {
+		line     string
+		expected Point
+	}{
+		{"foo.bar.baz 1.5 1500000000", Point{Name: "foo.bar.baz", Value: 1.5, Timestamp: 1500000000}},
+		{"foo.bar.baz  -2\t1500000000.75\n", Point{Name: "foo.bar.baz", Value: -2, Timestamp: 1500000000}},
+		{"cpu.idle;host=a;dc=east 3 1500000000", Point{Name: "cpu.idle", Tags: api.ParseTagSet("dc=east,host=a"), Value: 3, Timestamp: 1500000000}},
+	} {
+		a := assert.New(t).Contextf("%s", test.line)
+		point, err := ParseLine(test.line)
+		a.CheckError(err)
+		a.Eq(point, test.expected)
+	}
+}
+
+func TestParseLine_Errors(t *testing.T) {
+	for _, line := range []string{
+		"",
+		"foo.bar 1",
+		"foo.bar 1 2 3",
+		"foo.bar one 1500000000",
+		"foo.bar 1 yesterday",
+		";host=a 1 1500000000",
+		"cpu;host 1 1500000000",
+		"cpu;=a 1 1500000000",
+		"cpu;host= 1 1500000000",
+		"cpu;host=~a 1 1500000000",
+		"cpu;name=a 1 1500000000",
+	} {
+		a := assert.New(t).Contextf("%s", line)
+		_, err := ParseLine(line)
+		if _, ok := err.(ParseError); !ok {
+			a.Errorf("Expected a ParseError, got %#v", err)
+		}
+	}
+}
+
+type fakeMatcher struct{}
+
+func (fakeMatcher) MatchRule(input string) (api.TaggedMetric, bool) {
+	if input == "foo.server" {
+		return api.TaggedMetric{MetricKey: "foo", TagSet: api.ParseTagSet("app=server")}, true
+	}
+	return api.TaggedMetric{}, false
+}
+
+func TestPoint_TaggedMetric(t *testing.T) {
+	a := assert.New(t)
+	// Tagged series bypass the rules.
+	metric, ok := Point{Name: "cpu.idle", Tags: api.ParseTagSet("host=a")}.TaggedMetric(fakeMatcher{})
+	a.EqBool(ok, true)
+	a.Eq(metric, api.TaggedMetric{MetricKey: "cpu.idle", TagSet: api.ParseTagSet("host=a")})
+	metric, ok = Point{Name: "foo.server"}.TaggedMetric(fakeMatcher{})
+	a.EqBool(ok, true)
+	a.Eq(metric, api.TaggedMetric{MetricKey: "foo", TagSet: api.ParseTagSet("app=server")})
+	_, ok = Point{Name: "bar.server"}.TaggedMetric(fakeMatcher{})
+	a.EqBool(ok, false)
+}
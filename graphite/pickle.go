@@ -0,0 +1,548 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphite
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// maxPickleSize bounds the size of a pickle message, so that a corrupt header can't exhaust the memory.
+const maxPickleSize = 16 * 1024 * 1024
+
+// ReadPickle reads a message of the pickle protocol: a 4-byte big-endian length,
+// followed by a pickled list of (series, (timestamp, value)) tuples.
+// It returns io.EOF at the end of the input.
+func ReadPickle(reader io.Reader) ([]Point, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	if size > maxPickleSize {
+		return nil, ParseError{"pickle", fmt.Sprintf("message of %d bytes is too large", size)}
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		return nil, err
+	}
+	return ParsePickle(payload)
+}
+
+// ParsePickle parses a pickled list of (series, (timestamp, value)) tuples.
+// Only the opcodes building lists, tuples, strings and numbers are supported, so that no code is run.
+func ParsePickle(payload []byte) ([]Point, error) {
+	decoded, err := unpickle(payload)
+	if err != nil {
+		return nil, err
+	}
+	items, ok := asSequence(decoded)
+	if !ok {
+		return nil, ParseError{"pickle", "expected a list of points"}
+	}
+	points := make([]Point, 0, len(items))
+	for _, item := range items {
+		pair, ok := asSequence(item)
+		if !ok || len(pair) != 2 {
+			return nil, ParseError{"pickle", "expected a (series, (timestamp, value)) tuple"}
+		}
+		series, ok := pair[0].(string)
+		if !ok {
+			return nil, ParseError{"pickle", "expected the series to be a string"}
+		}
+		sample, ok := asSequence(pair[1])
+		if !ok || len(sample) != 2 {
+			return nil, ParseError{series, "expected a (timestamp, value) tuple"}
+		}
+		timestamp, ok := asFloat(sample[0])
+		if !ok {
+			return nil, ParseError{series, "invalid timestamp"}
+		}
+		value, ok := asFloat(sample[1])
+		if !ok {
+			return nil, ParseError{series, "invalid value"}
+		}
+		name, tags, err := parseSeries(series)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, Point{Name: name, Tags: tags, Value: value, Timestamp: int64(timestamp)})
+	}
+	return points, nil
+}
+
+// pickleList is a mutable list, shared by every reference to it.
+type pickleList struct {
+	items []interface{}
+}
+
+// pickleMark is pushed by the MARK opcode.
+type pickleMark struct{}
+
+// unpickler is a pickle machine supporting protocols 0 to 4, without the opcodes creating objects.
+// Values are nil, bool, int64, float64, string, []interface{} for tuples and *pickleList for lists.
+type unpickler struct {
+	data     []byte
+	position int
+	stack    []interface{}
+	memo     map[int]interface{}
+}
+
+func unpickle(data []byte) (interface{}, error) {
+	u := &unpickler{data: data, memo: make(map[int]interface{})}
+	for {
+		op, err := u.read(1)
+		if err != nil {
+			return nil, err
+		}
+		switch op[0] {
+		case '.': // STOP
+			if len(u.stack) != 1 {
+				return nil, u.errorf("unbalanced stack")
+			}
+			return u.stack[0], nil
+		case 0x80: // PROTO
+			_, err = u.read(1)
+		case 0x95: // FRAME
+			_, err = u.read(8)
+		case '(': // MARK
+			u.push(pickleMark{})
+		case ']': // EMPTY_LIST
+			u.push(&pickleList{})
+		case ')': // EMPTY_TUPLE
+			u.push([]interface{}{})
+		case 'l': // LIST
+			var items []interface{}
+			if items, err = u.popMark(); err == nil {
+				u.push(&pickleList{items})
+			}
+		case 't': // TUPLE
+			var items []interface{}
+			if items, err = u.popMark(); err == nil {
+				u.push(items)
+			}
+		case 0x85, 0x86, 0x87: // TUPLE1, TUPLE2, TUPLE3
+			var items []interface{}
+			if items, err = u.pop(int(op[0] - 0x84)); err == nil {
+				u.push(items)
+			}
+		case 'a': // APPEND
+			var items []interface{}
+			if items, err = u.pop(1); err == nil {
+				err = u.appendToList(items)
+			}
+		case 'e': // APPENDS
+			var items []interface{}
+			if items, err = u.popMark(); err == nil {
+				err = u.appendToList(items)
+			}
+		case 'N': // NONE
+			u.push(nil)
+		case 0x88: // NEWTRUE
+			u.push(true)
+		case 0x89: // NEWFALSE
+			u.push(false)
+		case 'K': // BININT1
+			err = u.readInt(1, false)
+		case 'M': // BININT2
+			err = u.readInt(2, false)
+		case 'J': // BININT
+			err = u.readInt(4, true)
+		case 'I': // INT
+			err = u.readTextInt()
+		case 'L': // LONG
+			err = u.readTextLong()
+		case 0x8a: // LONG1
+			err = u.readLong(1)
+		case 0x8b: // LONG4
+			err = u.readLong(4)
+		case 'G': // BINFLOAT
+			var raw []byte
+			if raw, err = u.read(8); err == nil {
+				u.push(math.Float64frombits(binary.BigEndian.Uint64(raw)))
+			}
+		case 'F': // FLOAT
+			var line string
+			if line, err = u.readLine(); err == nil {
+				var value float64
+				if value, err = strconv.ParseFloat(line, 64); err == nil {
+					u.push(value)
+				}
+			}
+		case 'U', 'C', 0x8c: // SHORT_BINSTRING, SHORT_BINBYTES, SHORT_BINUNICODE
+			err = u.readString(1)
+		case 'T', 'B', 'X': // BINSTRING, BINBYTES, BINUNICODE
+			err = u.readString(4)
+		case 0x8d, 0x8e: // BINUNICODE8, BINBYTES8
+			err = u.readString(8)
+		case 'S': // STRING
+			err = u.readQuotedString()
+		case 'V': // UNICODE
+			var line string
+			if line, err = u.readLine(); err == nil {
+				u.push(line)
+			}
+		case 'p': // PUT
+			var index int
+			if index, err = u.readTextIndex(); err == nil {
+				err = u.put(index)
+			}
+		case 'q': // BINPUT
+			var index int
+			if index, err = u.readIndex(1); err == nil {
+				err = u.put(index)
+			}
+		case 'r': // LONG_BINPUT
+			var index int
+			if index, err = u.readIndex(4); err == nil {
+				err = u.put(index)
+			}
+		case 0x94: // MEMOIZE
+			err = u.put(len(u.memo))
+		case 'g': // GET
+			var index int
+			if index, err = u.readTextIndex(); err == nil {
+				err = u.get(index)
+			}
+		case 'h': // BINGET
+			var index int
+			if index, err = u.readIndex(1); err == nil {
+				err = u.get(index)
+			}
+		case 'j': // LONG_BINGET
+			var index int
+			if index, err = u.readIndex(4); err == nil {
+				err = u.get(index)
+			}
+		default:
+			return nil, u.errorf("unsupported opcode 0x%02x", op[0])
+		}
+		if err != nil {
+			if _, ok := err.(ParseError); ok {
+				return nil, err
+			}
+			return nil, u.errorf("%s", err.Error())
+		}
+	}
+}
+
+func (u *unpickler) errorf(format string, args ...interface{}) error {
+	return ParseError{"pickle", fmt.Sprintf("at byte %d: ", u.position) + fmt.Sprintf(format, args...)}
+}
+
+func (u *unpickler) push(value interface{}) {
+	u.stack = append(u.stack, value)
+}
+
+// pop removes the last count values from the stack.
+func (u *unpickler) pop(count int) ([]interface{}, error) {
+	if len(u.stack) < count {
+		return nil, u.errorf("stack underflow")
+	}
+	items := append([]interface{}{}, u.stack[len(u.stack)-count:]...)
+	u.stack = u.stack[:len(u.stack)-count]
+	for _, item := range items {
+		if _, ok := item.(pickleMark); ok {
+			return nil, u.errorf("unexpected mark")
+		}
+	}
+	return items, nil
+}
+
+// popMark removes the values pushed since the last mark, and the mark.
+func (u *unpickler) popMark() ([]interface{}, error) {
+	for index := len(u.stack) - 1; index >= 0; index-- {
+		if _, ok := u.stack[index].(pickleMark); ok {
+			items := append([]interface{}{}, u.stack[index+1:]...)
+			u.stack = u.stack[:index]
+			return items, nil
+		}
+	}
+	return nil, u.errorf("no mark")
+}
+
+func (u *unpickler) appendToList(items []interface{}) error {
+	if len(u.stack) == 0 {
+		return u.errorf("stack underflow")
+	}
+	list, ok := u.stack[len(u.stack)-1].(*pickleList)
+	if !ok {
+		return u.errorf("expected a list")
+	}
+	list.items = append(list.items, items...)
+	return nil
+}
+
+func (u *unpickler) put(index int) error {
+	if len(u.stack) == 0 {
+		return u.errorf("stack underflow")
+	}
+	u.memo[index] = u.stack[len(u.stack)-1]
+	return nil
+}
+
+func (u *unpickler) get(index int) error {
+	value, ok := u.memo[index]
+	if !ok {
+		return u.errorf("unknown memo %d", index)
+	}
+	u.push(value)
+	return nil
+}
+
+func (u *unpickler) read(count int) ([]byte, error) {
+	if count < 0 || len(u.data)-u.position < count {
+		return nil, u.errorf("truncated")
+	}
+	result := u.data[u.position : u.position+count]
+	u.position += count
+	return result, nil
+}
+
+func (u *unpickler) readLine() (string, error) {
+	end := bytes.IndexByte(u.data[u.position:], '\n')
+	if end < 0 {
+		return "", u.errorf("truncated")
+	}
+	line := string(u.data[u.position : u.position+end])
+	u.position += end + 1
+	return line, nil
+}
+
+// readUint reads a little-endian unsigned integer of the given size.
+func (u *unpickler) readUint(size int) (uint64, error) {
+	raw, err := u.read(size)
+	if err != nil {
+		return 0, err
+	}
+	var value uint64
+	for index := size - 1; index >= 0; index-- {
+		value = value<<8 | uint64(raw[index])
+	}
+	return value, nil
+}
+
+func (u *unpickler) readInt(size int, signed bool) error {
+	value, err := u.readUint(size)
+	if err != nil {
+		return err
+	}
+	if signed {
+		u.push(int64(int32(uint32(value))))
+	} else {
+		u.push(int64(value))
+	}
+	return nil
+}
+
+func (u *unpickler) readIndex(size int) (int, error) {
+	value, err := u.readUint(size)
+	return int(value), err
+}
+
+func (u *unpickler) readTextIndex() (int, error) {
+	line, err := u.readLine()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(line)
+}
+
+func (u *unpickler) readTextInt() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	switch line {
+	case "00":
+		u.push(false)
+	case "01":
+		u.push(true)
+	default:
+		return u.pushBigInt(line)
+	}
+	return nil
+}
+
+func (u *unpickler) readTextLong() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	return u.pushBigInt(strings.TrimSuffix(line, "L"))
+}
+
+// readLong reads a little-endian two's complement integer, whose size is given by a header of the given size.
+func (u *unpickler) readLong(headerSize int) error {
+	size, err := u.readUint(headerSize)
+	if err != nil {
+		return err
+	}
+	if size > maxPickleSize {
+		return u.errorf("truncated")
+	}
+	raw, err := u.read(int(size))
+	if err != nil {
+		return err
+	}
+	bigEndian := make([]byte, len(raw))
+	for index := range raw {
+		bigEndian[len(raw)-1-index] = raw[index]
+	}
+	value := new(big.Int).SetBytes(bigEndian)
+	if len(raw) > 0 && raw[len(raw)-1]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), uint(8*len(raw))))
+	}
+	u.pushBig(value)
+	return nil
+}
+
+func (u *unpickler) pushBigInt(text string) error {
+	value, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return u.errorf("invalid integer '%s'", text)
+	}
+	u.pushBig(value)
+	return nil
+}
+
+// pushBig pushes the integer as an int64, or as a float64 if it's too large.
+func (u *unpickler) pushBig(value *big.Int) {
+	if value.IsInt64() {
+		u.push(value.Int64())
+		return
+	}
+	float, _ := new(big.Float).SetInt(value).Float64()
+	u.push(float)
+}
+
+// readString reads a string whose length is given by a little-endian header of the given size.
+func (u *unpickler) readString(headerSize int) error {
+	size, err := u.readUint(headerSize)
+	if err != nil {
+		return err
+	}
+	if size > uint64(len(u.data)) {
+		return u.errorf("truncated")
+	}
+	raw, err := u.read(int(size))
+	if err != nil {
+		return err
+	}
+	u.push(string(raw))
+	return nil
+}
+
+// readQuotedString reads the repr of a python 2 string.
+func (u *unpickler) readQuotedString() error {
+	line, err := u.readLine()
+	if err != nil {
+		return err
+	}
+	if len(line) < 2 || (line[0] != '\'' && line[0] != '"') || line[len(line)-1] != line[0] {
+		return u.errorf("invalid string %s", line)
+	}
+	value, ok := unescapeRepr(line[1 : len(line)-1])
+	if !ok {
+		return u.errorf("invalid string %s", line)
+	}
+	u.push(value)
+	return nil
+}
+
+// reprEscapes maps the characters following a backslash in a python 2 repr to the character they stand for.
+var reprEscapes = map[byte]byte{
+	'\\': '\\',
+	'\'': '\'',
+	'"':  '"',
+	'a':  '\a',
+	'b':  '\b',
+	'f':  '\f',
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'v':  '\v',
+}
+
+// unescapeRepr decodes the escape sequences of a python 2 string repr, without its quotes.
+// As in python, a backslash starting no escape sequence is kept.
+func unescapeRepr(input string) (string, bool) {
+	result := make([]byte, 0, len(input))
+	for i := 0; i < len(input); i++ {
+		if input[i] != '\\' {
+			result = append(result, input[i])
+			continue
+		}
+		i++
+		if i == len(input) {
+			return "", false // the closing quote is escaped.
+		}
+		if unescaped, ok := reprEscapes[input[i]]; ok {
+			result = append(result, unescaped)
+			continue
+		}
+		switch {
+		case input[i] == 'x':
+			if i+2 >= len(input) {
+				return "", false
+			}
+			value, err := strconv.ParseUint(input[i+1:i+3], 16, 8)
+			if err != nil {
+				return "", false
+			}
+			result = append(result, byte(value))
+			i += 2
+		case '0' <= input[i] && input[i] <= '7':
+			// Up to three octal digits.
+			end := i + 1
+			for end < len(input) && end < i+3 && '0' <= input[end] && input[end] <= '7' {
+				end++
+			}
+			value, _ := strconv.ParseUint(input[i:end], 8, 16)
+			result = append(result, byte(value))
+			i = end - 1
+		default:
+			result = append(result, '\\', input[i])
+		}
+	}
+	return string(result), true
+}
+
+// asSequence returns the items of a list or tuple.
+func asSequence(value interface{}) ([]interface{}, bool) {
+	switch value := value.(type) {
+	case []interface{}:
+		return value, true
+	case *pickleList:
+		return value.items, true
+	}
+	return nil, false
+}
+
+func asFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	}
+	return 0, false
+}
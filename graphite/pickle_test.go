@@ -0,0 +1,143 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphite
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func TestParsePickle(t *testing.T) {
+	// pickle.dumps([('foo.bar', (1500000000, 1.5)), ('cpu;host=a;dc=east', (1500000001.0, 2)), ('big', (1500000002, 10**20))], protocol)
+	expected := []Point{
+		{Name: "foo.bar", Value: 1.5, Timestamp: 1500000000},
+		{Name: "cpu", Tags: api.ParseTagSet("dc=east,host=a"), Value: 2, Timestamp: 1500000001},
+		{Name: "big", Value: 1e20, Timestamp: 1500000002},
+	}
+	for protocol, payload := range []string{
+		"(lp0\x0a(Vfoo.bar\x0ap1\x0a(I1500000000\x0aF1.5\x0atp2\x0atp3\x0aa(Vcpu;host=a;dc=east\x0ap4\x0a(F1500000001.0\x0aI2\x0atp5\x0atp6\x0aa(Vbig\x0ap7\x0a(I1500000002\x0aL100000000000000000000L\x0atp8\x0atp9\x0aa.",
+		"]q\x00((X\x07\x00\x00\x00foo.barq\x01(J\x00/hYG?\xf8\x00\x00\x00\x00\x00\x00tq\x02tq\x03(X\x12\x00\x00\x00cpu;host=a;dc=eastq\x04(GA\xd6Z\x0b\xc0@\x00\x00K\x02tq\x05tq\x06(X\x03\x00\x00\x00bigq\x07(J\x02/hYL100000000000000000000L\x0atq\x08tq\x09e.",
+		"\x80\x02]q\x00(X\x07\x00\x00\x00foo.barq\x01J\x00/hYG?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03X\x12\x00\x00\x00cpu;host=a;dc=eastq\x04GA\xd6Z\x0b\xc0@\x00\x00K\x02\x86q\x05\x86q\x06X\x03\x00\x00\x00bigq\x07J\x02/hY\x8a\x09\x00\x00\x10c-^\xc7k\x05\x86q\x08\x86q\x09e.",
+		"\x80\x03]q\x00(X\x07\x00\x00\x00foo.barq\x01J\x00/hYG?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03X\x12\x00\x00\x00cpu;host=a;dc=eastq\x04GA\xd6Z\x0b\xc0@\x00\x00K\x02\x86q\x05\x86q\x06X\x03\x00\x00\x00bigq\x07J\x02/hY\x8a\x09\x00\x00\x10c-^\xc7k\x05\x86q\x08\x86q\x09e.",
+		"\x80\x04\x95_\x00\x00\x00\x00\x00\x00\x00]\x94(\x8c\x07foo.bar\x94J\x00/hYG?\xf8\x00\x00\x00\x00\x00\x00\x86\x94\x86\x94\x8c\x12cpu;host=a;dc=east\x94GA\xd6Z\x0b\xc0@\x00\x00K\x02\x86\x94\x86\x94\x8c\x03big\x94J\x02/hY\x8a\x09\x00\x00\x10c-^\xc7k\x05\x86\x94\x86\x94e.",
+	} {
+		a := assert.New(t).Contextf("protocol %d", protocol)
+		points, err := ParsePickle([]byte(payload))
+		a.CheckError(err)
+		a.Eq(points, expected)
+	}
+}
+
+func TestParsePickle_Python2(t *testing.T) {
+	for _, test := range []struct {
+		payload  string
+		expected []Point
+	}{
+		// byte strings, and a memoized series
+		{
+			"\x80\x02]q\x00(U\x07foo.barq\x01J\x00/hYG?\xf8\x00\x00\x00\x00\x00\x00\x86q\x02\x86q\x03h\x01(K\x01K\x02tq\x04\x86q\x05e.",
+			[]Point{{Name: "foo.bar", Value: 1.5, Timestamp: 1500000000}, {Name: "foo.bar", Value: 2, Timestamp: 1}},
+		},
+		// quoted strings
+		{
+			"(lp0\x0a(S'foo.bar'\x0ap1\x0a(I1500000000\x0aF1.5\x0atp2\x0atp3\x0aa.",
+			[]Point{{Name: "foo.bar", Value: 1.5, Timestamp: 1500000000}},
+		},
+		// quoted strings with escapes, as written by repr
+		{
+			"(lp0\x0a(S'a\\\\\"b'\x0ap1\x0a(I1\x0aI2\x0atp2\x0atp3\x0aa(S\"it's\\x41\"\x0ap4\x0a(I3\x0aI4\x0atp5\x0atp6\x0aa.",
+			[]Point{{Name: `a\"b`, Value: 2, Timestamp: 1}, {Name: "it'sA", Value: 4, Timestamp: 3}},
+		},
+	} {
+		a := assert.New(t)
+		points, err := ParsePickle([]byte(test.payload))
+		a.CheckError(err)
+		a.Eq(points, test.expected)
+	}
+}
+
+func Test_unescapeRepr(t *testing.T) {
+	for _, test := range []struct {
+		repr     string
+		expected string
+	}{
+		{`foo.bar`, "foo.bar"},
+		{`a\\"b`, `a\"b`},
+		{`it's`, "it's"},
+		{`it\'s`, "it's"},
+		{`say \"hi\"`, `say "hi"`},
+		{`\x41\xff\t\n\r`, "A\xff\t\n\r"},
+		{`\0\101\1234`, "\x00A\x534"},
+		{`\q`, `\q`},
+	} {
+		a := assert.New(t).Contextf("%s", test.repr)
+		value, ok := unescapeRepr(test.repr)
+		a.EqBool(ok, true)
+		a.EqString(value, test.expected)
+	}
+	for _, repr := range []string{`\`, `a\x4`, `\xzz`} {
+		_, ok := unescapeRepr(repr)
+		assert.New(t).Contextf("%s", repr).EqBool(ok, false)
+	}
+}
+
+func TestParsePickle_Errors(t *testing.T) {
+	for _, payload := range []string{
+		"",
+		"]q\x00(",
+		// os.system, by GLOBAL and REDUCE
+		"cos\x0asystem\x0a(S'true'\x0atR.",
+		// not a list of points
+		"K\x01.",
+		"](K\x01K\x02te.",
+		"](U\x03foo(K\x01tte.",
+		"](U\x03foo(U\x01aK\x02tte.",
+		"](U\x01;(K\x01K\x02tte.",
+	} {
+		a := assert.New(t).Contextf("%q", payload)
+		_, err := ParsePickle([]byte(payload))
+		if _, ok := err.(ParseError); !ok {
+			a.Errorf("Expected a ParseError, got %#v", err)
+		}
+	}
+}
+
+func TestReadPickle(t *testing.T) {
+	a := assert.New(t)
+	payload := "\x80\x02]q\x00(U\x07foo.barq\x01(K\x01K\x02tq\x02\x86q\x03e."
+	buffer := &bytes.Buffer{}
+	for i := 0; i < 2; i++ {
+		binary.Write(buffer, binary.BigEndian, uint32(len(payload)))
+		buffer.WriteString(payload)
+	}
+	for i := 0; i < 2; i++ {
+		points, err := ReadPickle(buffer)
+		a.CheckError(err)
+		a.Eq(points, []Point{{Name: "foo.bar", Value: 2, Timestamp: 1}})
+	}
+	_, err := ReadPickle(buffer)
+	a.Eq(err, io.EOF)
+
+	binary.Write(buffer, binary.BigEndian, uint32(maxPickleSize+1))
+	_, err = ReadPickle(buffer)
+	if _, ok := err.(ParseError); !ok {
+		a.Errorf("Expected a ParseError, got %#v", err)
+	}
+}
@@ -1,49 +1,82 @@
 package internal
 
 import (
-	"io/ioutil"
-	"os"
+	"sync/atomic"
 	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/square/metrics/api"
+	"github.com/square/metrics/log"
 )
 
 // API implementations.
 type defaultAPI struct {
-	db      Database
-	ruleset RuleSet
+	db        Database
+	rulesPath string
+	ruleset   atomic.Value // of RuleSet, replaced when the rules are reloaded
+	limits    cardinalityLimits
+	// useTagSetIndex is set once tag_set_index holds every tagset, so that it can be read instead of metric_names.
+	useTagSetIndex bool
 }
 
 // NewAPI creates a new instance of API from the given configuration.
 func NewAPI(config api.Config) (api.API, error) {
-	file, err := os.Open(config.ConversionRulesPath)
+	ruleset, err := LoadRules(config.ConversionRulesPath)
 	if err != nil {
 		return nil, err
 	}
-	bytes, err := ioutil.ReadAll(file)
+	db, err := NewCassandraDatabase(newClusterConfig(config))
 	if err != nil {
 		return nil, err
 	}
-	ruleset, err := LoadYAML(bytes)
+	apiInstance := &defaultAPI{
+		db:             db,
+		rulesPath:      config.ConversionRulesPath,
+		limits:         newCardinalityLimits(config.MaxTagSetsPerMetric, config.MaxValuesPerTagKey),
+		useTagSetIndex: config.UseTagSetIndex,
+	}
+	apiInstance.ruleset.Store(ruleset)
+	return apiInstance, nil
+}
+
+// ReloadRules reads the rule file again, and replaces the rules once it compiles.
+// If it doesn't, the current rules are kept and the error is logged and returned.
+func (a *defaultAPI) ReloadRules() error {
+	ruleset, err := LoadRules(a.rulesPath)
 	if err != nil {
-		return nil, err
+		if ruleErr, ok := err.(RuleError); ok && ruleErr.MetricKey() != "" {
+			log.Errorf("Cannot reload the rules from %s, keeping the current rules: rule '%s': %s", a.rulesPath, ruleErr.MetricKey(), err.Error())
+		} else {
+			log.Errorf("Cannot reload the rules from %s, keeping the current rules: %s", a.rulesPath, err.Error())
+		}
+		return err
 	}
+	a.ruleset.Store(ruleset)
+	log.Infof("Reloaded %d rules from %s", len(ruleset.rules), a.rulesPath)
+	return nil
+}
+
+// rules returns the current rules.
+func (a *defaultAPI) rules() RuleSet {
+	ruleset, _ := a.ruleset.Load().(RuleSet)
+	return ruleset
+}
+
+// newClusterConfig creates the Cassandra configuration for the given API configuration.
+func newClusterConfig(config api.Config) *gocql.ClusterConfig {
 	clusterConfig := gocql.NewCluster()
 	clusterConfig.Hosts = config.Hosts
 	clusterConfig.Keyspace = config.Keyspace
 	clusterConfig.Timeout = time.Second * 30
-	db, err := NewCassandraDatabase(clusterConfig)
-	if err != nil {
-		return nil, err
-	}
-	return &defaultAPI{
-		db:      db,
-		ruleset: ruleset,
-	}, nil
+	return clusterConfig
 }
 
+// AddMetric adds the metric to the index. If the metric would exceed a cardinality limit,
+// nothing is written and a CardinalityError is returned.
 func (a *defaultAPI) AddMetric(metric api.TaggedMetric) error {
+	if err := a.limits.check(a.db, metric); err != nil {
+		return err
+	}
 	if err := a.db.AddMetricName(metric.MetricKey, metric.TagSet); err != nil {
 		return err
 	}
@@ -55,8 +88,75 @@ func (a *defaultAPI) AddMetric(metric api.TaggedMetric) error {
 	return nil
 }
 
-func (a *defaultAPI) GetAllTags(metricKey api.MetricKey) ([]api.TagSet, error) {
-	return a.db.GetTagSet(metricKey)
+// AddMetrics checks the cardinality limits for each metric, then writes the accepted metrics in batches.
+// The metrics accepted earlier in the same call count towards the limits.
+func (a *defaultAPI) AddMetrics(metrics []api.TaggedMetric) []error {
+	errors := make([]error, len(metrics))
+	accepted := []api.TaggedMetric{}
+	positions := []int{}
+	for i, metric := range metrics {
+		if err := a.limits.check(a.db, metric); err != nil {
+			errors[i] = err
+			continue
+		}
+		accepted = append(accepted, metric)
+		positions = append(positions, i)
+	}
+	for i, err := range a.db.AddMetrics(accepted) {
+		errors[positions[i]] = err
+	}
+	return errors
+}
+
+func (a *defaultAPI) RemoveMetrics(metrics []api.TaggedMetric) []error {
+	return a.db.RemoveMetrics(metrics)
+}
+
+func (a *defaultAPI) GetAllTags(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error) {
+	return a.db.GetTagSet(metricKey, activeSince)
+}
+
+// GetMatchingTags reads the tagsets of the metric having one of the values allowed by the predicate
+// for a tag key, using the tag set index when it is enabled. Otherwise, or without such constraints,
+// every tagset of the metric is read. The predicate is then applied to each tagset.
+func (a *defaultAPI) GetMatchingTags(metricKey api.MetricKey, predicate api.Predicate, activeSince time.Time) ([]api.TagSet, error) {
+	var tagSets []api.TagSet
+	var err error
+	if tagKey, tagValues, ok := narrowestConstraint(predicate); ok && a.useTagSetIndex {
+		tagSets, err = a.db.GetIndexedTagSets(metricKey, tagKey, tagValues, activeSince)
+	} else {
+		tagSets, err = a.db.GetTagSet(metricKey, activeSince)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := []api.TagSet{}
+	for _, tagSet := range tagSets {
+		if predicate.Apply(tagSet) {
+			result = append(result, tagSet)
+		}
+	}
+	return result, nil
+}
+
+// narrowestConstraint picks the tag key constrained by the predicate to the fewest values,
+// so that the fewest tagsets are read from the index.
+func narrowestConstraint(predicate api.Predicate) (string, []string, bool) {
+	indexable, ok := predicate.(api.IndexablePredicate)
+	if !ok {
+		return "", nil, false
+	}
+	found := false
+	bestKey := ""
+	var bestValues []string
+	for tagKey, tagValues := range indexable.Constraints() {
+		if !found || len(tagValues) < len(bestValues) || (len(tagValues) == len(bestValues) && tagKey < bestKey) {
+			found = true
+			bestKey = tagKey
+			bestValues = tagValues
+		}
+	}
+	return bestKey, bestValues, found
 }
 
 func (a *defaultAPI) GetMetricsForTag(tagKey, tagValue string) ([]api.MetricKey, error) {
@@ -67,29 +167,70 @@ func (a *defaultAPI) GetAllMetrics() ([]api.MetricKey, error) {
 	return a.db.GetAllMetrics()
 }
 
+func (a *defaultAPI) GetAllTagKeys() ([]string, error) {
+	return a.db.GetAllTagKeys()
+}
+
+func (a *defaultAPI) GetTagValues(tagKey string) ([]string, error) {
+	return a.db.GetTagValues(tagKey)
+}
+
+func (a *defaultAPI) SearchMetrics(query string, limit int) ([]api.MetricKey, error) {
+	return a.db.SearchMetrics(query, limit)
+}
+
+// ScanStaleMetrics calls the callback with the metrics which have not been added since the given time, a page at a time.
+func (a *defaultAPI) ScanStaleMetrics(seenBefore time.Time, callback func([]api.TaggedMetric) error) error {
+	return a.db.ScanStaleMetrics(seenBefore, callback)
+}
+
+// CacheStats reports the effectiveness of the caches used to avoid repeated writes.
+func (a *defaultAPI) CacheStats() map[string]CacheStats {
+	return a.db.CacheStats()
+}
+
+// RemoveMetric removes the tagset of the metric. The tag index entries and the metric key
+// are only removed once no remaining tagset of the metric uses them.
 func (a *defaultAPI) RemoveMetric(metric api.TaggedMetric) error {
-	if err := a.db.RemoveMetricName(metric.MetricKey, metric.TagSet); err != nil {
-		return err
-	}
-	for tagKey, tagValue := range metric.TagSet {
-		if err := a.db.RemoveFromTagIndex(tagKey, tagValue, metric.MetricKey); err != nil {
-			return err
-		}
-	}
-	return nil
+	return a.db.RemoveMetrics([]api.TaggedMetric{metric})[0]
 }
 
 func (a *defaultAPI) ToGraphiteName(metric api.TaggedMetric) (api.GraphiteMetric, error) {
-	return a.ruleset.ToGraphiteName(metric)
+	return a.rules().ToGraphiteName(metric)
 }
 
 func (a *defaultAPI) ToTaggedName(metric api.GraphiteMetric) (api.TaggedMetric, error) {
-	match, matched := a.ruleset.MatchRule(string(metric))
+	match, matched := a.rules().MatchRule(string(metric))
 	if matched {
 		return match, nil
 	}
 	return api.TaggedMetric{}, newNoMatch()
 }
 
+// ExpiringAPI is an API which records when metrics were last added, so that stale metrics can be removed.
+type ExpiringAPI interface {
+	api.API
+	// ScanStaleMetrics calls the callback with the metrics which have not been added since the given time,
+	// a page at a time, stopping at the first error of the callback.
+	// Metrics should not be considered stale until MinStaleAge has passed since they were added.
+	ScanStaleMetrics(seenBefore time.Time, callback func([]api.TaggedMetric) error) error
+}
+
+// CachingAPI is an API which caches its writes, so that they aren't repeated.
+type CachingAPI interface {
+	api.API
+	// CacheStats reports the effectiveness of each cache, by name.
+	CacheStats() map[string]CacheStats
+}
+
+// ReloadableAPI is an API whose conversion rules can be reloaded while it's in use.
+type ReloadableAPI interface {
+	api.API
+	// ReloadRules replaces the conversion rules with the current contents of the rule file.
+	ReloadRules() error
+}
+
 // ensure interface
-var _ api.API = (*defaultAPI)(nil)
+var _ ExpiringAPI = (*defaultAPI)(nil)
+var _ CachingAPI = (*defaultAPI)(nil)
+var _ ReloadableAPI = (*defaultAPI)(nil)
@@ -0,0 +1,153 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func TestReloadRules(t *testing.T) {
+	a := assert.New(t)
+	file, err := ioutil.TempFile("", "rules")
+	a.CheckError(err)
+	defer os.Remove(file.Name())
+	write := func(content string) {
+		a.CheckError(ioutil.WriteFile(file.Name(), []byte(content), 0644))
+	}
+	write(`
+rules:
+  -
+    pattern: foo.%app%
+    metric_key: foo
+`)
+	apiInstance := &defaultAPI{rulesPath: file.Name()}
+	a.CheckError(apiInstance.ReloadRules())
+	_, err = apiInstance.ToTaggedName(api.GraphiteMetric("foo.server"))
+	a.CheckError(err)
+
+	// The new rules replace the current ones.
+	write(`
+rules:
+  -
+    pattern: bar.%app%
+    metric_key: bar
+`)
+	a.CheckError(apiInstance.ReloadRules())
+	_, err = apiInstance.ToTaggedName(api.GraphiteMetric("foo.server"))
+	a.EqBool(err != nil, true)
+	metric, err := apiInstance.ToTaggedName(api.GraphiteMetric("bar.server"))
+	a.CheckError(err)
+	a.EqString(string(metric.MetricKey), "bar")
+
+	// Invalid rules are rejected, and the current ones are kept.
+	write(`
+rules:
+  -
+    pattern: baz.%app
+    metric_key: baz
+`)
+	err = apiInstance.ReloadRules()
+	if ruleErr, ok := err.(RuleError); !ok {
+		a.Errorf("Expected a RuleError, got %#v", err)
+	} else {
+		a.EqString(ruleErr.MetricKey(), "baz")
+	}
+	_, err = apiInstance.ToTaggedName(api.GraphiteMetric("bar.server"))
+	a.CheckError(err)
+}
+
+// constrainedPredicate is a predicate accepting the tagsets with one of the values for each of its tag keys.
+type constrainedPredicate map[string][]string
+
+func (p constrainedPredicate) Apply(tagSet api.TagSet) bool {
+	for tagKey, tagValues := range p {
+		found := false
+		for _, tagValue := range tagValues {
+			if tagSet[tagKey] == tagValue {
+				found = true
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (p constrainedPredicate) Constraints() map[string][]string {
+	return p
+}
+
+func Test_narrowestConstraint(t *testing.T) {
+	a := assert.New(t)
+	_, _, ok := narrowestConstraint(api.TruePredicate)
+	a.EqBool(ok, false)
+	_, _, ok = narrowestConstraint(constrainedPredicate{})
+	a.EqBool(ok, false)
+
+	tagKey, tagValues, ok := narrowestConstraint(constrainedPredicate{
+		"dc":   {"east", "west"},
+		"host": {"a", "b", "c"},
+		"app":  {"web", "api"},
+	})
+	a.EqBool(ok, true)
+	a.EqString(tagKey, "app")
+	a.Eq(tagValues, []string{"web", "api"})
+}
+
+// unindexedDatabase holds tagsets in metric_names which aren't in tag_set_index yet.
+type unindexedDatabase struct {
+	Database
+	tagSets []api.TagSet
+}
+
+func (db unindexedDatabase) GetTagSet(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error) {
+	return db.tagSets, nil
+}
+
+func (db unindexedDatabase) GetIndexedTagSets(metricKey api.MetricKey, tagKey string, tagValues []string, activeSince time.Time) ([]api.TagSet, error) {
+	return []api.TagSet{}, nil
+}
+
+func TestGetMatchingTags(t *testing.T) {
+	a := assert.New(t)
+	db := unindexedDatabase{tagSets: []api.TagSet{
+		api.ParseTagSet("dc=east,host=a"),
+		api.ParseTagSet("dc=west,host=b"),
+	}}
+	predicate := constrainedPredicate{"dc": {"west"}}
+
+	// Until the index is enabled, the tagsets are read from metric_names.
+	apiInstance := &defaultAPI{db: db}
+	tagSets, err := apiInstance.GetMatchingTags("cpu", predicate, time.Time{})
+	a.CheckError(err)
+	a.Eq(tagSets, []api.TagSet{api.ParseTagSet("dc=west,host=b")})
+
+	apiInstance = &defaultAPI{db: db, useTagSetIndex: true}
+	tagSets, err = apiInstance.GetMatchingTags("cpu", predicate, time.Time{})
+	a.CheckError(err)
+	a.Eq(tagSets, []api.TagSet{})
+
+	// Without constraints, metric_names is always read.
+	tagSets, err = apiInstance.GetMatchingTags("cpu", api.TruePredicate, time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tagSets), 2)
+}
@@ -0,0 +1,245 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/square/metrics/api"
+)
+
+const (
+	// maxBatchStatements bounds the number of statements in a single batch.
+	// All the statements for one metric are always in the same batch, so a batch may exceed it when it holds a single metric.
+	maxBatchStatements = 100
+	// batchConcurrency is the number of batches executed at the same time.
+	batchConcurrency = 8
+)
+
+// statement is a single query in a batch.
+type statement struct {
+	query  string
+	values []interface{}
+	done   func() // called once the batch holding the statement succeeds; may be nil.
+}
+
+// metricBatch groups the statements for several metrics.
+type metricBatch struct {
+	indices    []int // positions of the metrics in the input
+	statements []statement
+}
+
+// groupBatches splits the statements for each metric into batches of bounded size.
+func groupBatches(statements [][]statement) []metricBatch {
+	batches := []metricBatch{}
+	current := metricBatch{}
+	for i, metricStatements := range statements {
+		if len(current.statements) > 0 && len(current.statements)+len(metricStatements) > maxBatchStatements {
+			batches = append(batches, current)
+			current = metricBatch{}
+		}
+		current.indices = append(current.indices, i)
+		current.statements = append(current.statements, metricStatements...)
+	}
+	if len(current.indices) > 0 {
+		batches = append(batches, current)
+	}
+	return batches
+}
+
+// executeBatches runs the batches as unlogged batches, with bounded concurrency.
+// The error of each batch is reported for every metric in it.
+func (db *defaultDatabase) executeBatches(batches []metricBatch, count int) []error {
+	errors := make([]error, count)
+	work := make(chan metricBatch)
+	waiter := sync.WaitGroup{}
+	for i := 0; i < batchConcurrency; i++ {
+		waiter.Add(1)
+		go func() {
+			defer waiter.Done()
+			for batch := range work {
+				err := db.executeBatch(batch)
+				for _, index := range batch.indices {
+					// Each index belongs to a single batch, so there are no concurrent writes to an element.
+					errors[index] = err
+				}
+			}
+		}()
+	}
+	for _, batch := range batches {
+		work <- batch
+	}
+	close(work)
+	waiter.Wait()
+	return errors
+}
+
+func (db *defaultDatabase) executeBatch(batch metricBatch) error {
+	cqlBatch := db.session.NewBatch(gocql.UnloggedBatch)
+	for _, statement := range batch.statements {
+		cqlBatch.Query(statement.query, statement.values...)
+	}
+	if err := db.session.ExecuteBatch(cqlBatch); err != nil {
+		return err
+	}
+	for _, statement := range batch.statements {
+		if statement.done != nil {
+			statement.done()
+		}
+	}
+	return nil
+}
+
+// AddMetrics writes the metrics and their tag index entries in batches.
+// The returned slice holds the error for each metric, which is nil if the metric was written.
+func (db *defaultDatabase) AddMetrics(metrics []api.TaggedMetric) []error {
+	now := time.Now()
+	// Set and index entries shared by several metrics are only written once, with the first metric needing them.
+	// The other metrics depend on that metric, and fail if it does.
+	keyOwners := map[api.MetricKey]int{}
+	indexOwners := map[tagIndexCacheKey]int{}
+	tagKeyOwners := map[string]int{}
+	statements := make([][]statement, len(metrics))
+	dependencies := make([][]int, len(metrics))
+	for i, metric := range metrics {
+		serialized := metric.TagSet.Serialize()
+		if indexKey := (tagSetIndexCacheKey{metric.MetricKey, serialized}); !db.tagSetIndexCache.Has(indexKey) {
+			for tagKey, tagValue := range metric.TagSet {
+				statements[i] = append(statements[i], statement{
+					query:  "INSERT INTO tag_set_index (metric_key, tag_key, tag_value, tag_set) VALUES (?, ?, ?, ?)",
+					values: []interface{}{metric.MetricKey, tagKey, tagValue, serialized},
+					done: func() {
+						db.tagSetIndexCache.Add(indexKey)
+					},
+				})
+			}
+		}
+		statements[i] = append(statements[i], statement{
+			query:  "INSERT INTO metric_names (metric_key, tag_set, last_seen) VALUES (?, ?, ?)",
+			values: []interface{}{metric.MetricKey, serialized, now},
+		})
+		if metricKey := metric.MetricKey; !db.allMetricsCache.Has(metricKey) {
+			if owner, ok := keyOwners[metricKey]; ok {
+				dependencies[i] = append(dependencies[i], owner)
+			} else {
+				keyOwners[metricKey] = i
+				statements[i] = append(statements[i], statement{
+					query:  "UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?",
+					values: []interface{}{[]string{string(metricKey)}, metricNameShard(metricKey)},
+					done: func() {
+						db.allMetricsCache.Add(metricKey)
+						db.searchIndex.add(metricKey)
+					},
+				})
+			}
+		}
+		for tagKey, tagValue := range metric.TagSet {
+			if indexKey := (tagIndexCacheKey{tagKey, tagValue, metric.MetricKey}); !db.tagIndexCache.Has(indexKey) {
+				if owner, ok := indexOwners[indexKey]; ok {
+					dependencies[i] = append(dependencies[i], owner)
+				} else {
+					indexOwners[indexKey] = i
+					statements[i] = append(statements[i], statement{
+						query:  "UPDATE tag_index SET metric_keys = metric_keys + ? WHERE tag_key = ? AND tag_value = ?",
+						values: []interface{}{[]string{string(metric.MetricKey)}, tagKey, tagValue},
+						done: func() {
+							db.tagIndexCache.Add(indexKey)
+						},
+					})
+				}
+			}
+			if key := tagKey; !db.tagKeysCache.Has(key) {
+				if owner, ok := tagKeyOwners[key]; ok {
+					dependencies[i] = append(dependencies[i], owner)
+				} else {
+					tagKeyOwners[key] = i
+					statements[i] = append(statements[i], statement{
+						query:  "UPDATE tag_key_set SET tag_keys = tag_keys + ? WHERE shard = ?",
+						values: []interface{}{[]string{key}, 0},
+						done: func() {
+							db.tagKeysCache.Add(key)
+						},
+					})
+				}
+			}
+		}
+	}
+	return propagateErrors(db.executeBatches(groupBatches(statements), len(metrics)), dependencies)
+}
+
+// propagateErrors reports the error of a metric for each metric depending on it.
+// dependencies lists, for each metric, the metrics whose statements it depends on.
+func propagateErrors(errors []error, dependencies [][]int) []error {
+	result := make([]error, len(errors))
+	for i := range errors {
+		result[i] = errors[i]
+		for _, owner := range dependencies[i] {
+			if result[i] != nil {
+				break
+			}
+			result[i] = errors[owner]
+		}
+	}
+	return result
+}
+
+// RemoveMetrics deletes the metrics in batches. Then, for each metric key, the tag index entries
+// and the metric_name_set entry which no remaining tagset uses are removed.
+// The returned slice holds the error for each metric, which is nil if the metric was removed.
+func (db *defaultDatabase) RemoveMetrics(metrics []api.TaggedMetric) []error {
+	statements := make([][]statement, len(metrics))
+	for i, metric := range metrics {
+		serialized := metric.TagSet.Serialize()
+		// Forget the tagset in the cache before deleting, as RemoveMetricName does.
+		db.tagSetIndexCache.Remove(tagSetIndexCacheKey{metric.MetricKey, serialized})
+		statements[i] = append(statements[i], statement{
+			query:  "DELETE FROM metric_names WHERE metric_key = ? AND tag_set = ?",
+			values: []interface{}{metric.MetricKey, serialized},
+		})
+		for tagKey, tagValue := range metric.TagSet {
+			statements[i] = append(statements[i], statement{
+				query:  "DELETE FROM tag_set_index WHERE metric_key = ? AND tag_key = ? AND tag_value = ? AND tag_set = ?",
+				values: []interface{}{metric.MetricKey, tagKey, tagValue, serialized},
+			})
+		}
+	}
+	errors := db.executeBatches(groupBatches(statements), len(metrics))
+	// The metrics removed for each metric key, in order.
+	removed := map[api.MetricKey][]int{}
+	metricKeys := []api.MetricKey{}
+	for i, metric := range metrics {
+		if errors[i] != nil {
+			continue
+		}
+		if _, ok := removed[metric.MetricKey]; !ok {
+			metricKeys = append(metricKeys, metric.MetricKey)
+		}
+		removed[metric.MetricKey] = append(removed[metric.MetricKey], i)
+	}
+	for _, metricKey := range metricKeys {
+		tagSets := make([]api.TagSet, len(removed[metricKey]))
+		for j, i := range removed[metricKey] {
+			tagSets[j] = metrics[i].TagSet
+		}
+		if err := db.removeUnusedIndexEntries(metricKey, tagSets); err != nil {
+			for _, i := range removed[metricKey] {
+				errors[i] = err
+			}
+		}
+	}
+	return errors
+}
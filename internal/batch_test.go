@@ -0,0 +1,68 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/square/metrics/assert"
+)
+
+func Test_groupBatches(t *testing.T) {
+	a := assert.New(t)
+	statements := func(count int) []statement {
+		return make([]statement, count)
+	}
+	for _, test := range []struct {
+		sizes    []int   // number of statements for each metric
+		expected [][]int // indices of the metrics in each batch
+	}{
+		{[]int{}, [][]int{}},
+		{[]int{1, 2, 3}, [][]int{{0, 1, 2}}},
+		{[]int{60, 40, 1}, [][]int{{0, 1}, {2}}},
+		{[]int{60, 41, 1}, [][]int{{0}, {1, 2}}},
+		// A metric needing more than a full batch is kept whole.
+		{[]int{1, 150, 1}, [][]int{{0}, {1}, {2}}},
+	} {
+		input := [][]statement{}
+		for _, size := range test.sizes {
+			input = append(input, statements(size))
+		}
+		batches := groupBatches(input)
+		actual := [][]int{}
+		for _, batch := range batches {
+			actual = append(actual, batch.indices)
+			size := 0
+			for _, index := range batch.indices {
+				size += test.sizes[index]
+			}
+			a.Contextf("sizes=%v", test.sizes).EqInt(len(batch.statements), size)
+		}
+		a.Contextf("sizes=%v", test.sizes).Eq(actual, test.expected)
+	}
+}
+
+func Test_propagateErrors(t *testing.T) {
+	a := assert.New(t)
+	failure := errors.New("batch failed")
+	other := errors.New("other batch failed")
+	// Metric 1 failed, and metrics 2 and 3 depend on the index entries written with it.
+	actual := propagateErrors(
+		[]error{nil, failure, nil, nil, other},
+		[][]int{{}, {}, {1}, {0, 1}, {1}},
+	)
+	a.Eq(actual, []error{nil, failure, failure, failure, other})
+}
@@ -0,0 +1,164 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	// writeCacheSize is the maximum number of entries in each write cache.
+	writeCacheSize = 100000
+	// writeCacheTTL is how long an entry is kept, so that writes are periodically repeated.
+	writeCacheTTL = time.Hour
+)
+
+// MinStaleAge is how long metrics must not have been added for before they can be removed as stale.
+// An indexer may not repeat the index writes of a metric it added less than writeCacheTTL ago,
+// so removing index entries it relies on would leave them missing.
+const MinStaleAge = writeCacheTTL
+
+// CacheStats reports the effectiveness of a cache.
+type CacheStats struct {
+	Hits   int64 `json:"hits"`
+	Misses int64 `json:"misses"`
+	Size   int   `json:"size"`
+}
+
+// writeCache remembers recent writes, so that they aren't repeated, along with an optional value for each.
+// It holds at most capacity entries, evicting the least recently used,
+// and forgets entries older than the TTL.
+type writeCache struct {
+	mutex    sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[interface{}]*list.Element
+	order    *list.List // of *cacheEntry, most recently used first
+	hits     int64
+	misses   int64
+	now      func() time.Time
+}
+
+type cacheEntry struct {
+	key     interface{}
+	value   interface{}
+	expires time.Time
+}
+
+func newWriteCache(capacity int, ttl time.Duration) *writeCache {
+	return &writeCache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[interface{}]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Has checks whether the key was written recently.
+func (cache *writeCache) Has(key interface{}) bool {
+	_, ok := cache.Get(key)
+	return ok
+}
+
+// Get returns the value of the key, if it was written recently.
+func (cache *writeCache) Get(key interface{}) (interface{}, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, ok := cache.entries[key]
+	if ok && cache.now().After(element.Value.(*cacheEntry).expires) {
+		cache.remove(element)
+		ok = false
+	}
+	if !ok {
+		cache.misses++
+		return nil, false
+	}
+	cache.hits++
+	cache.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).value, true
+}
+
+// Add remembers that the key was written.
+func (cache *writeCache) Add(key interface{}) {
+	cache.Put(key, nil)
+}
+
+// Put remembers that the key was written, with the given value.
+func (cache *writeCache) Put(key interface{}, value interface{}) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	expires := cache.now().Add(cache.ttl)
+	if element, ok := cache.entries[key]; ok {
+		entry := element.Value.(*cacheEntry)
+		entry.value = value
+		entry.expires = expires
+		cache.order.MoveToFront(element)
+		return
+	}
+	cache.entries[key] = cache.order.PushFront(&cacheEntry{key, value, expires})
+	for cache.order.Len() > cache.capacity {
+		cache.remove(cache.order.Back())
+	}
+}
+
+// Update replaces the value of the key with the result of update, without extending its TTL.
+// It does nothing if the key isn't in the cache.
+func (cache *writeCache) Update(key interface{}, update func(value interface{}) interface{}) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, ok := cache.entries[key]
+	if !ok || cache.now().After(element.Value.(*cacheEntry).expires) {
+		return
+	}
+	entry := element.Value.(*cacheEntry)
+	entry.value = update(entry.value)
+}
+
+// Remove forgets the key, so that it will be written again.
+func (cache *writeCache) Remove(key interface{}) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if element, ok := cache.entries[key]; ok {
+		cache.remove(element)
+	}
+}
+
+// Retain forgets every key for which keep returns false.
+func (cache *writeCache) Retain(keep func(key interface{}) bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	for element := cache.order.Front(); element != nil; {
+		next := element.Next()
+		if !keep(element.Value.(*cacheEntry).key) {
+			cache.remove(element)
+		}
+		element = next
+	}
+}
+
+// Stats returns the number of hits and misses so far, and the current size.
+func (cache *writeCache) Stats() CacheStats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return CacheStats{Hits: cache.hits, Misses: cache.misses, Size: cache.order.Len()}
+}
+
+func (cache *writeCache) remove(element *list.Element) {
+	delete(cache.entries, element.Value.(*cacheEntry).key)
+	cache.order.Remove(element)
+}
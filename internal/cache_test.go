@@ -0,0 +1,83 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/square/metrics/assert"
+)
+
+func Test_writeCache(t *testing.T) {
+	a := assert.New(t)
+	now := time.Unix(0, 0)
+	cache := newWriteCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	a.EqBool(cache.Has("a"), false)
+	cache.Add("a")
+	cache.Add("b")
+	a.EqBool(cache.Has("a"), true)
+	a.EqBool(cache.Has("b"), true)
+
+	// "a" is the least recently used, so it's evicted.
+	cache.Add("c")
+	a.EqBool(cache.Has("a"), false)
+	a.EqBool(cache.Has("b"), true)
+	a.EqBool(cache.Has("c"), true)
+
+	cache.Remove("b")
+	a.EqBool(cache.Has("b"), false)
+
+	// Entries expire once they are older than the TTL.
+	now = now.Add(45 * time.Second)
+	cache.Add("d")
+	now = now.Add(30 * time.Second)
+	a.EqBool(cache.Has("c"), false)
+	a.EqBool(cache.Has("d"), true)
+
+	a.Eq(cache.Stats(), CacheStats{Hits: 5, Misses: 4, Size: 1})
+
+	cache.Add("e")
+	cache.Retain(func(key interface{}) bool { return key == "e" })
+	a.EqBool(cache.Has("d"), false)
+	a.EqBool(cache.Has("e"), true)
+}
+
+func Test_writeCacheValues(t *testing.T) {
+	a := assert.New(t)
+	now := time.Unix(0, 0)
+	cache := newWriteCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", 1)
+	value, ok := cache.Get("a")
+	a.EqBool(ok, true)
+	a.Eq(value, 1)
+
+	increment := func(value interface{}) interface{} { return value.(int) + 1 }
+	now = now.Add(45 * time.Second)
+	cache.Update("a", increment)
+	value, _ = cache.Get("a")
+	a.Eq(value, 2)
+	cache.Update("b", increment)
+	a.EqBool(cache.Has("b"), false)
+
+	// Updates don't extend the TTL.
+	now = now.Add(30 * time.Second)
+	_, ok = cache.Get("a")
+	a.EqBool(ok, false)
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"time"
+
+	"github.com/square/metrics/api"
+)
+
+// cardinalityCountTTL is how long the number of tagsets of a metric, or of values of a tag key, is used
+// before it's counted again, so that metrics added by other indexers and removed metrics are accounted for.
+const cardinalityCountTTL = 5 * time.Minute
+
+// tagValue is a value of a tag key.
+type tagValue struct {
+	key   string
+	value string
+}
+
+// cardinalityLimits bound the number of tagsets per metric and values per tag key. Zero means unlimited.
+// The tagsets and values known to be stored, and the number of each, are cached,
+// so that the database is rarely read when checking a metric.
+// The cached numbers also count the metrics accepted since, so they may be too high if writes fail.
+type cardinalityLimits struct {
+	tagSetsPerMetric int
+	valuesPerTagKey  int
+	knownTagSets     *writeCache // of tagSetIndexCacheKey
+	knownValues      *writeCache // of tagValue
+	tagSetCounts     *writeCache // of api.MetricKey, holding the number of tagsets of the metric
+	valueCounts      *writeCache // of string, holding the number of values of the tag key
+}
+
+func newCardinalityLimits(tagSetsPerMetric, valuesPerTagKey int) cardinalityLimits {
+	return cardinalityLimits{
+		tagSetsPerMetric: tagSetsPerMetric,
+		valuesPerTagKey:  valuesPerTagKey,
+		knownTagSets:     newWriteCache(writeCacheSize, writeCacheTTL),
+		knownValues:      newWriteCache(writeCacheSize, writeCacheTTL),
+		tagSetCounts:     newWriteCache(writeCacheSize, cardinalityCountTTL),
+		valueCounts:      newWriteCache(writeCacheSize, cardinalityCountTTL),
+	}
+}
+
+func (limits cardinalityLimits) enabled() bool {
+	return limits.tagSetsPerMetric > 0 || limits.valuesPerTagKey > 0
+}
+
+// check returns a CardinalityError if adding the metric would exceed a limit.
+// Tagsets which have already been added are always accepted. Accepted metrics are counted.
+func (limits cardinalityLimits) check(db Database, metric api.TaggedMetric) error {
+	if !limits.enabled() {
+		return nil
+	}
+	tagSetKey := tagSetIndexCacheKey{metric.MetricKey, metric.TagSet.Serialize()}
+	if limits.knownTagSets.Has(tagSetKey) {
+		return nil
+	}
+	exists, err := db.HasTagSet(metric.MetricKey, metric.TagSet)
+	if err != nil {
+		return err
+	}
+	if exists {
+		limits.knownTagSets.Add(tagSetKey)
+		return nil
+	}
+	if limits.tagSetsPerMetric > 0 {
+		count, err := cachedCount(limits.tagSetCounts, metric.MetricKey, func() (int, error) {
+			return db.CountTagSets(metric.MetricKey)
+		})
+		if err != nil {
+			return err
+		}
+		if count >= limits.tagSetsPerMetric {
+			return newTooManyTagSets(metric, limits.tagSetsPerMetric)
+		}
+	}
+	newValues := []tagValue{}
+	if limits.valuesPerTagKey > 0 {
+		for key, value := range metric.TagSet {
+			valueKey := tagValue{key, value}
+			if limits.knownValues.Has(valueKey) {
+				continue
+			}
+			exists, err := db.HasTagValue(key, value)
+			if err != nil {
+				return err
+			}
+			if exists {
+				limits.knownValues.Add(valueKey)
+				continue
+			}
+			count, err := cachedCount(limits.valueCounts, key, func() (int, error) {
+				return db.CountTagValues(key)
+			})
+			if err != nil {
+				return err
+			}
+			if count >= limits.valuesPerTagKey {
+				return newTooManyTagValues(metric, key, limits.valuesPerTagKey)
+			}
+			newValues = append(newValues, valueKey)
+		}
+	}
+	limits.knownTagSets.Add(tagSetKey)
+	limits.tagSetCounts.Update(metric.MetricKey, increment)
+	for _, valueKey := range newValues {
+		limits.knownValues.Add(valueKey)
+		limits.valueCounts.Update(valueKey.key, increment)
+	}
+	return nil
+}
+
+// cachedCount returns the count for the key from the cache, or counts and caches it.
+func cachedCount(cache *writeCache, key interface{}, count func() (int, error)) (int, error) {
+	if value, ok := cache.Get(key); ok {
+		return value.(int), nil
+	}
+	result, err := count()
+	if err != nil {
+		return 0, err
+	}
+	cache.Put(key, result)
+	return result, nil
+}
+
+func increment(value interface{}) interface{} {
+	return value.(int) + 1
+}
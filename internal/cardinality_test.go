@@ -0,0 +1,111 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+// countingDatabase holds stored tagsets in memory, and counts the reads made to check cardinality limits.
+type countingDatabase struct {
+	Database // not implemented, so other methods panic.
+	tagSets  map[api.MetricKey]map[string]bool
+	reads    map[string]int
+}
+
+func (db *countingDatabase) HasTagSet(metricKey api.MetricKey, tagSet api.TagSet) (bool, error) {
+	db.reads["HasTagSet"]++
+	return db.tagSets[metricKey][tagSet.Serialize()], nil
+}
+
+func (db *countingDatabase) CountTagSets(metricKey api.MetricKey) (int, error) {
+	db.reads["CountTagSets"]++
+	return len(db.tagSets[metricKey]), nil
+}
+
+func (db *countingDatabase) HasTagValue(tagKey, tagValue string) (bool, error) {
+	db.reads["HasTagValue"]++
+	for _, tagSets := range db.tagSets {
+		for serialized := range tagSets {
+			if api.ParseTagSet(serialized)[tagKey] == tagValue {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (db *countingDatabase) CountTagValues(tagKey string) (int, error) {
+	db.reads["CountTagValues"]++
+	values := map[string]bool{}
+	for _, tagSets := range db.tagSets {
+		for serialized := range tagSets {
+			if value, ok := api.ParseTagSet(serialized)[tagKey]; ok {
+				values[value] = true
+			}
+		}
+	}
+	return len(values), nil
+}
+
+func Test_cardinalityLimits(t *testing.T) {
+	a := assert.New(t)
+	db := &countingDatabase{
+		tagSets: map[api.MetricKey]map[string]bool{
+			"metric.a": {"host=a": true},
+		},
+		reads: map[string]int{},
+	}
+	limits := newCardinalityLimits(3, 3)
+	check := func(metricKey api.MetricKey, tagSet string) error {
+		return limits.check(db, api.TaggedMetric{MetricKey: metricKey, TagSet: api.ParseTagSet(tagSet)})
+	}
+
+	// Stored tagsets are only read once.
+	a.CheckError(check("metric.a", "host=a"))
+	a.CheckError(check("metric.a", "host=a"))
+	a.EqInt(db.reads["HasTagSet"], 1)
+	a.EqInt(db.reads["CountTagSets"], 0)
+
+	// New tagsets are counted in memory once the metric's tagsets have been counted.
+	a.CheckError(check("metric.a", "host=b"))
+	a.CheckError(check("metric.a", "host=c"))
+	a.EqInt(db.reads["CountTagSets"], 1)
+	a.EqInt(db.reads["CountTagValues"], 1)
+
+	// Rejected tagsets don't count the tagsets again.
+	for _, tagSet := range []string{"host=d", "host=e"} {
+		err := check("metric.a", tagSet)
+		if cardinalityErr, ok := err.(CardinalityError); !ok {
+			t.Errorf("Expected a CardinalityError but got %+v", err)
+		} else {
+			a.EqInt(int(cardinalityErr.Code()), int(TooManyTagSets))
+		}
+	}
+	a.EqInt(db.reads["CountTagSets"], 1)
+
+	// The values accepted for metric.a count towards the limit of the tag key.
+	err := check("metric.b", "host=f")
+	if cardinalityErr, ok := err.(CardinalityError); !ok {
+		t.Errorf("Expected a CardinalityError but got %+v", err)
+	} else {
+		a.EqInt(int(cardinalityErr.Code()), int(TooManyTagValues))
+	}
+	a.CheckError(check("metric.b", "host=a"))
+	a.EqInt(db.reads["CountTagValues"], 1)
+}
@@ -16,29 +16,52 @@
 package internal
 
 import (
-	"sync"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/square/metrics/api"
 )
 
+const (
+	// maxTagSetLookup bounds the number of tagsets read from metric_names in a single query.
+	maxTagSetLookup = 100
+	// stalePageSize is the number of rows read at once when scanning metric_names for stale metrics,
+	// and the most stale metrics handed over at once.
+	stalePageSize = 1000
+)
+
 // Database represents internal connection to Cassandra.
 type Database interface {
 	// Insertion Methods
 	// -----------------
 	AddMetricName(metricKey api.MetricKey, metric api.TagSet) error
 	AddToTagIndex(tagKey, tagValue string, metricKey api.MetricKey) error
+	AddMetrics(metrics []api.TaggedMetric) []error
 
 	// Query methods
 	// -------------
-	GetTagSet(metricKey api.MetricKey) ([]api.TagSet, error)
+	GetTagSet(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error)
+	GetIndexedTagSets(metricKey api.MetricKey, tagKey string, tagValues []string, activeSince time.Time) ([]api.TagSet, error)
 	GetMetricKeys(tagKey, tagValue string) ([]api.MetricKey, error)
 	GetAllMetrics() ([]api.MetricKey, error)
+	GetAllTagKeys() ([]string, error)
+	GetTagValues(tagKey string) ([]string, error)
+	SearchMetrics(query string, limit int) ([]api.MetricKey, error)
+	ScanStaleMetrics(seenBefore time.Time, callback func([]api.TaggedMetric) error) error
+	HasTagSet(metricKey api.MetricKey, tagSet api.TagSet) (bool, error)
+	CountTagSets(metricKey api.MetricKey) (int, error)
+	HasTagValue(tagKey, tagValue string) (bool, error)
+	CountTagValues(tagKey string) (int, error)
 
 	// Deletion Method
 	// ---------------
 	RemoveMetricName(metricKey api.MetricKey, tagSet api.TagSet) error
 	RemoveFromTagIndex(tagKey, tagValue string, metricKey api.MetricKey) error
+	RemoveMetrics(metrics []api.TaggedMetric) []error
+
+	// Monitoring Methods
+	// ------------------
+	CacheStats() map[string]CacheStats
 }
 
 type tagIndexCacheKey struct {
@@ -47,12 +70,18 @@ type tagIndexCacheKey struct {
 	metric api.MetricKey
 }
 
+type tagSetIndexCacheKey struct {
+	metric api.MetricKey
+	tagSet string
+}
+
 type defaultDatabase struct {
-	session         *gocql.Session
-	allMetricsCache map[api.MetricKey]bool
-	allMetricsMutex *sync.Mutex
-	tagIndexCache   map[tagIndexCacheKey]bool
-	tagIndexMutex   *sync.Mutex
+	session          *gocql.Session
+	allMetricsCache  *writeCache // of api.MetricKey
+	tagIndexCache    *writeCache // of tagIndexCacheKey
+	tagKeysCache     *writeCache // of string
+	tagSetIndexCache *writeCache // of tagSetIndexCacheKey
+	searchIndex      *metricIndex
 }
 
 // NewCassandraDatabase creates an instance of database, backed by Cassandra.
@@ -62,44 +91,51 @@ func NewCassandraDatabase(clusterConfig *gocql.ClusterConfig) (Database, error)
 		return nil, err
 	}
 	return &defaultDatabase{
-		session:         session,
-		allMetricsCache: make(map[api.MetricKey]bool),
-		allMetricsMutex: &sync.Mutex{},
-		tagIndexCache:   make(map[tagIndexCacheKey]bool),
-		tagIndexMutex:   &sync.Mutex{},
+		session:          session,
+		allMetricsCache:  newWriteCache(writeCacheSize, writeCacheTTL),
+		tagIndexCache:    newWriteCache(writeCacheSize, writeCacheTTL),
+		tagKeysCache:     newWriteCache(writeCacheSize, writeCacheTTL),
+		tagSetIndexCache: newWriteCache(writeCacheSize, writeCacheTTL),
+		searchIndex:      newMetricIndex(),
 	}, nil
 }
 
-// AddMetricName inserts to metric to Cassandra.
-func (db *defaultDatabase) AddMetricName(metricKey api.MetricKey, tagSet api.TagSet) error {
+// CacheStats reports the effectiveness of each write cache.
+func (db *defaultDatabase) CacheStats() map[string]CacheStats {
+	return map[string]CacheStats{
+		"metric_names":  db.allMetricsCache.Stats(),
+		"tag_index":     db.tagIndexCache.Stats(),
+		"tag_keys":      db.tagKeysCache.Stats(),
+		"tag_set_index": db.tagSetIndexCache.Stats(),
+	}
+}
 
-	if err := db.session.Query("INSERT INTO metric_names (metric_key, tag_set) VALUES (?, ?)", metricKey, tagSet.Serialize()).Exec(); err != nil {
+// AddMetricName inserts to metric to Cassandra, recording the current time as when it was last seen.
+func (db *defaultDatabase) AddMetricName(metricKey api.MetricKey, tagSet api.TagSet) error {
+	// The tagset is indexed first, since index entries without a metric_names row are ignored.
+	if err := db.addToTagSetIndex(metricKey, tagSet); err != nil {
+		return err
+	}
+	if err := db.session.Query("INSERT INTO metric_names (metric_key, tag_set, last_seen) VALUES (?, ?, ?)", metricKey, tagSet.Serialize(), time.Now()).Exec(); err != nil {
 		return err
 	}
-	db.allMetricsMutex.Lock()
-	if db.allMetricsCache[metricKey] {
-		db.allMetricsMutex.Unlock()
+	if db.allMetricsCache.Has(metricKey) {
 		// If the key is found in the cache, exit early.
 		return nil
 	}
-	db.allMetricsMutex.Unlock()
-	if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?", []string{string(metricKey)}, 0).Exec(); err != nil {
+	if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?", []string{string(metricKey)}, metricNameShard(metricKey)).Exec(); err != nil {
 		return err
 	}
-	db.allMetricsMutex.Lock()
-	// Remember the cached value so that it won't be written again in the absence of reads.
-	db.allMetricsCache[metricKey] = true
-	db.allMetricsMutex.Unlock()
+	// Remember the cached value so that it won't be written again until it expires.
+	db.allMetricsCache.Add(metricKey)
+	db.searchIndex.add(metricKey)
 	return nil
 
 }
 
 func (db *defaultDatabase) AddToTagIndex(tagKey string, tagValue string, metricKey api.MetricKey) error {
 	indexKey := tagIndexCacheKey{tagKey, tagValue, metricKey}
-	db.tagIndexMutex.Lock()
-	indexValue := db.tagIndexCache[indexKey]
-	db.tagIndexMutex.Unlock()
-	if indexValue {
+	if db.tagIndexCache.Has(indexKey) {
 		return nil // Found in the cache so already in the table, so no need to perform a write.
 	}
 	err := db.session.Query(
@@ -108,23 +144,66 @@ func (db *defaultDatabase) AddToTagIndex(tagKey string, tagValue string, metricK
 		tagKey,
 		tagValue,
 	).Exec()
-	if err == nil {
-		db.tagIndexMutex.Lock()
-		// Remember this write in the cache.
-		db.tagIndexCache[indexKey] = true
-		db.tagIndexMutex.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := db.addTagKey(tagKey); err != nil {
+		return err
 	}
-	return err
+	// Remember this write in the cache.
+	db.tagIndexCache.Add(indexKey)
+	return nil
 }
 
-func (db *defaultDatabase) GetTagSet(metricKey api.MetricKey) ([]api.TagSet, error) {
+// addToTagSetIndex records the tagset under each of its tag values.
+func (db *defaultDatabase) addToTagSetIndex(metricKey api.MetricKey, tagSet api.TagSet) error {
+	serialized := tagSet.Serialize()
+	indexKey := tagSetIndexCacheKey{metricKey, serialized}
+	if db.tagSetIndexCache.Has(indexKey) {
+		return nil
+	}
+	for tagKey, tagValue := range tagSet {
+		if err := db.session.Query(
+			"INSERT INTO tag_set_index (metric_key, tag_key, tag_value, tag_set) VALUES (?, ?, ?, ?)",
+			metricKey,
+			tagKey,
+			tagValue,
+			serialized,
+		).Exec(); err != nil {
+			return err
+		}
+	}
+	db.tagSetIndexCache.Add(indexKey)
+	return nil
+}
+
+// addTagKey records the tag key in the set of all tag keys.
+func (db *defaultDatabase) addTagKey(tagKey string) error {
+	if db.tagKeysCache.Has(tagKey) {
+		// If the key is found in the cache, exit early.
+		return nil
+	}
+	if err := db.session.Query("UPDATE tag_key_set SET tag_keys = tag_keys + ? WHERE shard = ?", []string{tagKey}, 0).Exec(); err != nil {
+		return err
+	}
+	db.tagKeysCache.Add(tagKey)
+	return nil
+}
+
+// GetTagSet lists the tagsets of the metric. If activeSince is non-zero, tagsets last seen before it are skipped.
+// Rows written before last-seen times were recorded have no last-seen time, and are never skipped.
+func (db *defaultDatabase) GetTagSet(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error) {
 	var tags []api.TagSet
 	rawTag := ""
+	var lastSeen time.Time
 	iterator := db.session.Query(
-		"SELECT tag_set FROM metric_names WHERE metric_key = ?",
+		"SELECT tag_set, last_seen FROM metric_names WHERE metric_key = ?",
 		metricKey,
 	).Iter()
-	for iterator.Scan(&rawTag) {
+	for iterator.Scan(&rawTag, &lastSeen) {
+		if !activeSince.IsZero() && !lastSeen.IsZero() && lastSeen.Before(activeSince) {
+			continue
+		}
 		parsedTagSet := api.ParseTagSet(rawTag)
 		if parsedTagSet != nil {
 			tags = append(tags, parsedTagSet)
@@ -136,6 +215,59 @@ func (db *defaultDatabase) GetTagSet(metricKey api.MetricKey) ([]api.TagSet, err
 	return tags, nil
 }
 
+// GetIndexedTagSets lists the tagsets of the metric which have one of the values for the tag key,
+// using tag_set_index. As with GetTagSet, tagsets last seen before activeSince are skipped if it is non-zero.
+func (db *defaultDatabase) GetIndexedTagSets(metricKey api.MetricKey, tagKey string, tagValues []string, activeSince time.Time) ([]api.TagSet, error) {
+	candidates := []string{}
+	seen := map[string]bool{}
+	for _, tagValue := range tagValues {
+		var rawTag string
+		iterator := db.session.Query(
+			"SELECT tag_set FROM tag_set_index WHERE metric_key = ? AND tag_key = ? AND tag_value = ?",
+			metricKey,
+			tagKey,
+			tagValue,
+		).Iter()
+		for iterator.Scan(&rawTag) {
+			if !seen[rawTag] {
+				seen[rawTag] = true
+				candidates = append(candidates, rawTag)
+			}
+		}
+		if err := iterator.Close(); err != nil {
+			return nil, err
+		}
+	}
+	// metric_names is read for the last-seen times, and to skip index entries left behind by failed deletions.
+	tags := []api.TagSet{}
+	for start := 0; start < len(candidates); start += maxTagSetLookup {
+		end := start + maxTagSetLookup
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		rawTag := ""
+		var lastSeen time.Time
+		iterator := db.session.Query(
+			"SELECT tag_set, last_seen FROM metric_names WHERE metric_key = ? AND tag_set IN ?",
+			metricKey,
+			candidates[start:end],
+		).Iter()
+		for iterator.Scan(&rawTag, &lastSeen) {
+			if !activeSince.IsZero() && !lastSeen.IsZero() && lastSeen.Before(activeSince) {
+				continue
+			}
+			parsedTagSet := api.ParseTagSet(rawTag)
+			if parsedTagSet != nil {
+				tags = append(tags, parsedTagSet)
+			}
+		}
+		if err := iterator.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
+
 func (db *defaultDatabase) GetMetricKeys(tagKey string, tagValue string) ([]api.MetricKey, error) {
 	var keys []api.MetricKey
 	err := db.session.Query(
@@ -153,39 +285,185 @@ func (db *defaultDatabase) GetMetricKeys(tagKey string, tagValue string) ([]api.
 }
 
 func (db *defaultDatabase) GetAllMetrics() ([]api.MetricKey, error) {
-	var keys []api.MetricKey
-	err := db.session.Query("SELECT metric_names FROM metric_name_set WHERE shard = ?", 0).Scan(&keys)
+	keys, err := db.readMetricNameShards()
 	if err != nil {
 		return nil, err
 	}
-	db.allMetricsMutex.Lock()
-	db.allMetricsCache = make(map[api.MetricKey]bool)
+	// Forget the cached keys which are no longer stored, so that they will be written again.
+	stored := make(map[api.MetricKey]bool, len(keys))
 	for _, key := range keys {
-		db.allMetricsCache[key] = true
+		stored[key] = true
 	}
-	db.allMetricsMutex.Unlock()
+	db.allMetricsCache.Retain(func(key interface{}) bool {
+		return stored[key.(api.MetricKey)]
+	})
+	db.searchIndex.replace(keys, time.Now())
 	return keys, nil
 }
 
+// SearchMetrics finds metric names starting with or containing the query.
+// The in-memory index is reloaded from metric_name_set when it is stale.
+func (db *defaultDatabase) SearchMetrics(query string, limit int) ([]api.MetricKey, error) {
+	if db.searchIndex.stale(time.Now()) {
+		if _, err := db.GetAllMetrics(); err != nil {
+			return nil, err
+		}
+	}
+	return db.searchIndex.search(query, limit), nil
+}
+
+// GetAllTagKeys lists the tag keys which have been added to the tag index.
+// Keys are not removed from the set when their metrics are, so it may hold keys no metric uses anymore.
+func (db *defaultDatabase) GetAllTagKeys() ([]string, error) {
+	var keys []string
+	err := db.session.Query("SELECT tag_keys FROM tag_key_set WHERE shard = ?", 0).Scan(&keys)
+	if err != nil && err != gocql.ErrNotFound {
+		return nil, err
+	}
+	if keys == nil {
+		keys = []string{} // so that it's encoded as an empty list.
+	}
+	stored := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		stored[key] = true
+	}
+	db.tagKeysCache.Retain(func(key interface{}) bool {
+		return stored[key.(string)]
+	})
+	return keys, nil
+}
+
+// GetTagValues lists the values of the given tag key which are used by at least one metric.
+// Rows of the tag index whose set of metrics has been emptied by deletions are skipped.
+func (db *defaultDatabase) GetTagValues(tagKey string) ([]string, error) {
+	values := []string{}
+	var value string
+	var metricKeys []string
+	iterator := db.session.Query(
+		"SELECT tag_value, metric_keys FROM tag_index WHERE tag_key = ?",
+		tagKey,
+	).Iter()
+	for iterator.Scan(&value, &metricKeys) {
+		if len(metricKeys) > 0 {
+			values = append(values, value)
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// HasTagSet checks whether the tagset has already been added to the metric.
+func (db *defaultDatabase) HasTagSet(metricKey api.MetricKey, tagSet api.TagSet) (bool, error) {
+	var rawTag string
+	err := db.session.Query(
+		"SELECT tag_set FROM metric_names WHERE metric_key = ? AND tag_set = ?",
+		metricKey,
+		tagSet.Serialize(),
+	).Scan(&rawTag)
+	if err == gocql.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// CountTagSets counts the tagsets of the metric.
+func (db *defaultDatabase) CountTagSets(metricKey api.MetricKey) (int, error) {
+	var count int64
+	if err := db.session.Query("SELECT COUNT(*) FROM metric_names WHERE metric_key = ?", metricKey).Scan(&count); err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// HasTagValue checks whether the tag value is used by at least one metric, as GetTagValues does.
+func (db *defaultDatabase) HasTagValue(tagKey, tagValue string) (bool, error) {
+	keys, err := db.GetMetricKeys(tagKey, tagValue)
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// CountTagValues counts the values of the tag key listed by GetTagValues.
+func (db *defaultDatabase) CountTagValues(tagKey string) (int, error) {
+	values, err := db.GetTagValues(tagKey)
+	if err != nil {
+		return 0, err
+	}
+	return len(values), nil
+}
+
+// ScanStaleMetrics scans every metric for the tagsets last seen before the given time,
+// and calls the callback with them, up to stalePageSize at a time. It stops at the first error of the callback.
+// Rows without a last-seen time are not considered stale.
+func (db *defaultDatabase) ScanStaleMetrics(seenBefore time.Time, callback func([]api.TaggedMetric) error) error {
+	page := []api.TaggedMetric{}
+	var metricKey string
+	rawTag := ""
+	var lastSeen time.Time
+	iterator := db.session.Query("SELECT metric_key, tag_set, last_seen FROM metric_names").PageSize(stalePageSize).Iter()
+	for iterator.Scan(&metricKey, &rawTag, &lastSeen) {
+		if lastSeen.IsZero() || !lastSeen.Before(seenBefore) {
+			continue
+		}
+		parsedTagSet := api.ParseTagSet(rawTag)
+		if parsedTagSet == nil {
+			continue
+		}
+		page = append(page, api.TaggedMetric{api.MetricKey(metricKey), parsedTagSet})
+		if len(page) == stalePageSize {
+			if err := callback(page); err != nil {
+				iterator.Close()
+				return err
+			}
+			page = []api.TaggedMetric{}
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+	if len(page) > 0 {
+		return callback(page)
+	}
+	return nil
+}
+
 func (db *defaultDatabase) RemoveMetricName(metricKey api.MetricKey, tagSet api.TagSet) error {
-	db.allMetricsMutex.Lock()
 	// Forget the metric in the cache.
 	// (If this delete fails, there will be an extraneous write the next time the metric is consumed).
-	db.allMetricsCache[metricKey] = false
-	db.allMetricsMutex.Unlock()
-	return db.session.Query(
+	db.allMetricsCache.Remove(metricKey)
+	serialized := tagSet.Serialize()
+	db.tagSetIndexCache.Remove(tagSetIndexCacheKey{metricKey, serialized})
+	if err := db.session.Query(
 		"DELETE FROM metric_names WHERE metric_key = ? AND tag_set = ?",
 		metricKey,
-		tagSet.Serialize(),
-	).Exec()
+		serialized,
+	).Exec(); err != nil {
+		return err
+	}
+	for tagKey, tagValue := range tagSet {
+		if err := db.session.Query(
+			"DELETE FROM tag_set_index WHERE metric_key = ? AND tag_key = ? AND tag_value = ? AND tag_set = ?",
+			metricKey,
+			tagKey,
+			tagValue,
+			serialized,
+		).Exec(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (db *defaultDatabase) RemoveFromTagIndex(tagKey string, tagValue string, metricKey api.MetricKey) error {
 	// Forget the tag key/value/metric triplet in the cache.
 	// (If this delete fails, there will be an extraneous write the next time they are consumed).
-	db.tagIndexMutex.Lock()
-	db.tagIndexCache[tagIndexCacheKey{tagKey, tagValue, metricKey}] = false
-	db.tagIndexMutex.Unlock()
+	db.tagIndexCache.Remove(tagIndexCacheKey{tagKey, tagValue, metricKey})
 	return db.session.Query(
 		"UPDATE tag_index SET metric_keys = metric_keys - ? WHERE tag_key = ? AND tag_value = ?",
 		[]string{string(metricKey)},
@@ -193,3 +471,36 @@ func (db *defaultDatabase) RemoveFromTagIndex(tagKey string, tagValue string, me
 		tagValue,
 	).Exec()
 }
+
+// removeUnusedIndexEntries is called once tagsets of the metric have been removed. It removes the metric
+// from the tag index entries of the removed tagsets which no remaining tagset of the metric uses,
+// and removes the metric key from metric_name_set once the metric has no tagsets left.
+func (db *defaultDatabase) removeUnusedIndexEntries(metricKey api.MetricKey, removed []api.TagSet) error {
+	remaining, err := db.GetTagSet(metricKey, time.Time{})
+	if err != nil {
+		return err
+	}
+	used := map[tagIndexCacheKey]bool{}
+	for _, tagSet := range remaining {
+		for tagKey, tagValue := range tagSet {
+			used[tagIndexCacheKey{tagKey, tagValue, metricKey}] = true
+		}
+	}
+	for _, tagSet := range removed {
+		for tagKey, tagValue := range tagSet {
+			indexKey := tagIndexCacheKey{tagKey, tagValue, metricKey}
+			if used[indexKey] {
+				continue
+			}
+			used[indexKey] = true // so that it's only removed once.
+			if err := db.RemoveFromTagIndex(tagKey, tagValue, metricKey); err != nil {
+				return err
+			}
+		}
+	}
+	if len(remaining) > 0 {
+		return nil
+	}
+	db.allMetricsCache.Remove(metricKey)
+	return db.removeMetricKey(metricKey)
+}
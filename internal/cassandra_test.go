@@ -17,8 +17,8 @@ package internal
 import (
 	"fmt"
 	"sort"
-	"sync"
 	"testing"
+	"time"
 
 	"github.com/gocql/gocql"
 	"github.com/square/metrics/api"
@@ -33,7 +33,7 @@ func newDatabase(t *testing.T) *defaultDatabase {
 		t.Errorf("Cannot connect to Cassandra")
 		return nil
 	}
-	tables := []string{"metric_names", "tag_index", "metric_name_set"}
+	tables := []string{"metric_names", "tag_index", "metric_name_set", "tag_key_set", "tag_set_index"}
 	for _, table := range tables {
 		if err := session.Query(fmt.Sprintf("TRUNCATE %s", table)).Exec(); err != nil {
 			t.Errorf("Cannot truncate %s: %s", table, err.Error())
@@ -41,11 +41,12 @@ func newDatabase(t *testing.T) *defaultDatabase {
 		}
 	}
 	return &defaultDatabase{
-		session:         session,
-		allMetricsCache: make(map[api.MetricKey]bool),
-		allMetricsMutex: &sync.Mutex{},
-		tagIndexCache:   make(map[tagIndexCacheKey]bool),
-		tagIndexMutex:   &sync.Mutex{},
+		session:          session,
+		allMetricsCache:  newWriteCache(writeCacheSize, writeCacheTTL),
+		tagIndexCache:    newWriteCache(writeCacheSize, writeCacheTTL),
+		tagKeysCache:     newWriteCache(writeCacheSize, writeCacheTTL),
+		tagSetIndexCache: newWriteCache(writeCacheSize, writeCacheTTL),
+		searchIndex:      newMetricIndex(),
 	}
 }
 
@@ -63,7 +64,7 @@ func Test_MetricName_GetTagSet(t *testing.T) {
 	if db == nil {
 		return
 	}
-	if tags, err := db.GetTagSet("sample"); err != nil {
+	if tags, err := db.GetTagSet("sample", time.Time{}); err != nil {
 		t.Errorf("Error fetching tags from Cassandra")
 	} else {
 		a.EqInt(len(tags), 0)
@@ -101,7 +102,7 @@ func Test_MetricName_GetTagSet(t *testing.T) {
 		}
 
 		for k, v := range c.expectedTags {
-			if tags, err := db.GetTagSet(api.MetricKey(k)); err != nil {
+			if tags, err := db.GetTagSet(api.MetricKey(k), time.Time{}); err != nil {
 				t.Errorf("Error fetching tags")
 			} else {
 				stringTags := make([]string, len(tags))
@@ -170,3 +171,262 @@ func Test_TagIndex(t *testing.T) {
 		a.EqString(string(rows[0]), "d.e.f")
 	}
 }
+
+func Test_GetIndexedTagSets(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	a.CheckError(db.AddMetricName("metric.a", api.ParseTagSet("dc=west,host=a")))
+	a.CheckError(db.AddMetricName("metric.a", api.ParseTagSet("dc=west,host=b")))
+	a.CheckError(db.AddMetricName("metric.a", api.ParseTagSet("dc=east,host=c")))
+	a.CheckError(db.AddMetricName("metric.b", api.ParseTagSet("dc=west,host=d")))
+
+	tags, err := db.GetIndexedTagSets("metric.a", "dc", []string{"west"}, time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tags), 2)
+	tags, err = db.GetIndexedTagSets("metric.a", "host", []string{"a", "c", "d"}, time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tags), 2)
+
+	// Removed tagsets are no longer indexed.
+	a.CheckError(db.RemoveMetricName("metric.a", api.ParseTagSet("dc=west,host=a")))
+	tags, err = db.GetIndexedTagSets("metric.a", "dc", []string{"west"}, time.Time{})
+	a.CheckError(err)
+	a.Eq(tags, []api.TagSet{api.ParseTagSet("dc=west,host=b")})
+}
+
+func Test_TagKeysAndValues(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	keys, err := db.GetAllTagKeys()
+	a.CheckError(err)
+	a.EqInt(len(keys), 0)
+
+	a.CheckError(db.AddToTagIndex("environment", "production", "a.b.c"))
+	a.CheckError(db.AddToTagIndex("environment", "staging", "a.b.c"))
+	a.CheckError(db.AddToTagIndex("host", "a", "d.e.f"))
+	keys, err = db.GetAllTagKeys()
+	a.CheckError(err)
+	sort.Strings(keys)
+	a.Eq(keys, []string{"environment", "host"})
+
+	values, err := db.GetTagValues("environment")
+	a.CheckError(err)
+	sort.Strings(values)
+	a.Eq(values, []string{"production", "staging"})
+
+	// Values with no remaining metrics are not listed.
+	a.CheckError(db.RemoveFromTagIndex("environment", "staging", "a.b.c"))
+	values, err = db.GetTagValues("environment")
+	a.CheckError(err)
+	a.Eq(values, []string{"production"})
+}
+
+func Test_SearchMetrics(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	a.CheckError(db.AddMetricName("cpu.user", api.ParseTagSet("host=a")))
+	a.CheckError(db.AddMetricName("host.cpu", api.ParseTagSet("host=a")))
+	a.CheckError(db.AddMetricName("memory", api.ParseTagSet("host=a")))
+	keys, err := db.SearchMetrics("cpu", 10)
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{"cpu.user", "host.cpu"})
+	keys, err = db.SearchMetrics("cpu", 1)
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{"cpu.user"})
+}
+
+func Test_LastSeen(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	a.CheckError(db.AddMetricName("metric.a", api.ParseTagSet("host=old")))
+	time.Sleep(10 * time.Millisecond)
+	cutoff := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	a.CheckError(db.AddMetricName("metric.a", api.ParseTagSet("host=new")))
+
+	tags, err := db.GetTagSet("metric.a", time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tags), 2)
+	tags, err = db.GetTagSet("metric.a", cutoff)
+	a.CheckError(err)
+	a.Eq(tags, []api.TagSet{api.ParseTagSet("host=new")})
+
+	stale := []api.TaggedMetric{}
+	a.CheckError(db.ScanStaleMetrics(cutoff, func(page []api.TaggedMetric) error {
+		stale = append(stale, page...)
+		return nil
+	}))
+	a.Eq(stale, []api.TaggedMetric{{"metric.a", api.ParseTagSet("host=old")}})
+}
+
+func Test_CardinalityLimits(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	apiInstance := &defaultAPI{db: db, limits: newCardinalityLimits(2, 3)}
+	a.CheckError(apiInstance.AddMetric(api.TaggedMetric{"metric.a", api.ParseTagSet("host=a")}))
+	a.CheckError(apiInstance.AddMetric(api.TaggedMetric{"metric.a", api.ParseTagSet("host=b")}))
+	// Existing tagsets are always accepted.
+	a.CheckError(apiInstance.AddMetric(api.TaggedMetric{"metric.a", api.ParseTagSet("host=a")}))
+
+	err := apiInstance.AddMetric(api.TaggedMetric{"metric.a", api.ParseTagSet("host=c")})
+	if cardinalityErr, ok := err.(CardinalityError); !ok {
+		t.Errorf("Expected a CardinalityError but got %+v", err)
+	} else {
+		a.EqInt(int(cardinalityErr.Code()), int(TooManyTagSets))
+	}
+
+	a.CheckError(apiInstance.AddMetric(api.TaggedMetric{"metric.b", api.ParseTagSet("host=c")}))
+	err = apiInstance.AddMetric(api.TaggedMetric{"metric.b", api.ParseTagSet("host=d")})
+	if cardinalityErr, ok := err.(CardinalityError); !ok {
+		t.Errorf("Expected a CardinalityError but got %+v", err)
+	} else {
+		a.EqInt(int(cardinalityErr.Code()), int(TooManyTagValues))
+	}
+	tags, err := db.GetTagSet("metric.b", time.Time{})
+	a.CheckError(err)
+	a.Eq(tags, []api.TagSet{api.ParseTagSet("host=c")})
+}
+
+func Test_AddRemoveMetrics(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	metrics := []api.TaggedMetric{}
+	for i := 0; i < 250; i++ {
+		metrics = append(metrics, api.TaggedMetric{"metric.a", api.ParseTagSet(fmt.Sprintf("host=h%d,dc=west", i))})
+	}
+	metrics = append(metrics, api.TaggedMetric{"metric.b", api.ParseTagSet("dc=east")})
+	for _, err := range db.AddMetrics(metrics) {
+		a.CheckError(err)
+	}
+	tags, err := db.GetTagSet("metric.a", time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tags), 250)
+	keys, err := db.GetAllMetrics()
+	a.CheckError(err)
+	sort.Sort(api.MetricKeys(keys))
+	a.Eq(keys, []api.MetricKey{"metric.a", "metric.b"})
+	keys, err = db.GetMetricKeys("dc", "west")
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{"metric.a"})
+
+	// Index entries still used by remaining tagsets are kept.
+	for _, err := range db.RemoveMetrics(metrics[:249]) {
+		a.CheckError(err)
+	}
+	keys, err = db.GetMetricKeys("dc", "west")
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{"metric.a"})
+	keys, err = db.GetMetricKeys("host", "h0")
+	a.CheckError(err)
+	a.EqInt(len(keys), 0)
+
+	for _, err := range db.RemoveMetrics(metrics[249:250]) {
+		a.CheckError(err)
+	}
+	tags, err = db.GetTagSet("metric.a", time.Time{})
+	a.CheckError(err)
+	a.EqInt(len(tags), 0)
+	keys, err = db.GetMetricKeys("dc", "west")
+	a.CheckError(err)
+	a.EqInt(len(keys), 0)
+	// The metric key is removed along with its last tagset.
+	keys, err = db.GetAllMetrics()
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{"metric.b"})
+}
+
+func Test_MigrateMetricNameSet(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	// Write the keys as earlier versions did, all in shard 0.
+	expected := []api.MetricKey{}
+	for i := 0; i < 20; i++ {
+		key := api.MetricKey(fmt.Sprintf("metric.%d", i))
+		expected = append(expected, key)
+		addUnmigratedMetric(a, db, key)
+	}
+	sort.Sort(api.MetricKeys(expected))
+	// A key without tagsets is dropped.
+	a.CheckError(db.session.Query("UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?", []string{"metric.removed"}, 0).Exec())
+	keys, err := db.GetAllMetrics()
+	a.CheckError(err)
+	a.EqInt(len(keys), len(expected)+1)
+
+	_, err = db.migrateMetricNameSet()
+	a.CheckError(err)
+	keys, err = db.GetAllMetrics()
+	a.CheckError(err)
+	sort.Sort(api.MetricKeys(keys))
+	a.Eq(keys, expected)
+	remaining, err := db.readMetricNameShard(0)
+	a.CheckError(err)
+	for _, key := range remaining {
+		a.EqInt(metricNameShard(key), 0)
+	}
+	// Running it again moves nothing.
+	moved, err := db.migrateMetricNameSet()
+	a.CheckError(err)
+	a.EqInt(moved, 0)
+}
+
+// addUnmigratedMetric writes the metric as earlier versions did, with its key in shard 0 of metric_name_set.
+func addUnmigratedMetric(a assert.Assert, db *defaultDatabase, metricKey api.MetricKey) {
+	a.CheckError(db.session.Query("INSERT INTO metric_names (metric_key, tag_set, last_seen) VALUES (?, ?, ?)", metricKey, "host=a", time.Now()).Exec())
+	a.CheckError(db.session.Query("UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?", []string{string(metricKey)}, 0).Exec())
+}
+
+func Test_RemoveUnmigratedMetric(t *testing.T) {
+	a := assert.New(t)
+	db := newDatabase(t)
+	if db == nil {
+		return
+	}
+	defer cleanDatabase(t, db)
+	// A key hashed to shard 0 is never moved, so only keys of other shards are unmigrated.
+	key := api.MetricKey("metric.a")
+	for i := 0; metricNameShard(key) == 0; i++ {
+		key = api.MetricKey(fmt.Sprintf("metric.%d", i))
+	}
+	addUnmigratedMetric(a, db, key)
+	keys, err := db.GetAllMetrics()
+	a.CheckError(err)
+	a.Eq(keys, []api.MetricKey{key})
+
+	for _, err := range db.RemoveMetrics([]api.TaggedMetric{{key, api.ParseTagSet("host=a")}}) {
+		a.CheckError(err)
+	}
+	keys, err = db.GetAllMetrics()
+	a.CheckError(err)
+	a.EqInt(len(keys), 0)
+	remaining, err := db.readMetricNameShard(0)
+	a.CheckError(err)
+	a.EqInt(len(remaining), 0)
+}
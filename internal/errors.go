@@ -4,6 +4,8 @@ package internal
 
 import (
 	"fmt"
+
+	"github.com/square/metrics/api"
 )
 
 // RuleErrorCode is the error enum raised while YAML rule files is being compiled.
@@ -18,6 +20,16 @@ const (
 	InvalidMetricKey
 	// InvalidCustomRegex is retruned when the custom regex is invalid.
 	InvalidCustomRegex
+	// InvalidExample is returned when a rule does not convert one of its examples as expected.
+	InvalidExample
+	// InvalidTransform is returned when a tag transform is invalid, or can't be reversed by a rule which isn't one-way.
+	InvalidTransform
+	// InvalidStaticTag is returned when a static tag is also extracted from the graphite metric.
+	InvalidStaticTag
+	// InvalidInclude is returned when a rule file cannot be found or read, or includes itself.
+	InvalidInclude
+	// DuplicateMetricKey is returned when rules in different files have the same metric key.
+	DuplicateMetricKey
 )
 
 // ConversionErrorCode is the error enum raised while the metrics are converted
@@ -33,6 +45,18 @@ const (
 	NoMatch
 	// UnusedTag is returned during the reverse mapping, when a tag is present in the taglist but is not used
 	UnusedTag
+	// MetricKeyMismatch is returned during the reverse mapping, when the metric key does not match the rule.
+	MetricKeyMismatch
+)
+
+// CardinalityErrorCode is the error enum raised when adding a metric would exceed a cardinality limit.
+type CardinalityErrorCode int
+
+const (
+	// TooManyTagSets is returned when a metric already has the maximum number of tagsets.
+	TooManyTagSets CardinalityErrorCode = iota + 1
+	// TooManyTagValues is returned when a tag key already has the maximum number of values.
+	TooManyTagValues
 )
 
 // RuleError is the actual error object, wrapping RuleErrorCode and related metadata.
@@ -50,6 +74,14 @@ type ConversionError interface {
 	error
 }
 
+// CardinalityError is the actual error object, wrapping CardinalityErrorCode and related metadata.
+type CardinalityError interface {
+	Code() CardinalityErrorCode
+	// Metric which was rejected.
+	Metric() api.TaggedMetric
+	error
+}
+
 // Implementations
 // ===============
 
@@ -59,6 +91,13 @@ type ruleError struct {
 	message   string
 }
 
+// ruleFileError adds the location of the rule to a RuleError.
+type ruleFileError struct {
+	RuleError
+	file string
+	line int // zero if unknown.
+}
+
 type conversionError struct {
 	code    ConversionErrorCode
 	message string
@@ -85,6 +124,38 @@ func newInvalidMetricKey(metricKey string) RuleError {
 func newInvalidCustomRegex(metricKey string) RuleError {
 	return ruleError{InvalidCustomRegex, metricKey, fmt.Sprintf("Invalid custom regex in key '%s'", metricKey)}
 }
+func newInvalidTransform(metricKey string, tag string) RuleError {
+	return ruleError{InvalidTransform, metricKey, fmt.Sprintf("Invalid transform of tag '%s' in key '%s'", tag, metricKey)}
+}
+func newInvalidStaticTag(metricKey string, tag string) RuleError {
+	return ruleError{InvalidStaticTag, metricKey, fmt.Sprintf("Static tag '%s' is also extracted in key '%s'", tag, metricKey)}
+}
+func newInvalidExample(metricKey string, graphite string, reason string) RuleError {
+	return ruleError{InvalidExample, metricKey, fmt.Sprintf("Invalid example '%s' in key '%s': %s", graphite, metricKey, reason)}
+}
+
+func newInvalidInclude(file string, reason string) RuleError {
+	return ruleError{InvalidInclude, "", fmt.Sprintf("Cannot read rule file '%s': %s", file, reason)}
+}
+func newDuplicateMetricKey(metricKey string, file string, line int) RuleError {
+	return ruleError{DuplicateMetricKey, metricKey, fmt.Sprintf("Metric key '%s' is also used at %s", metricKey, location(file, line))}
+}
+
+func (err ruleFileError) Error() string {
+	return fmt.Sprintf("%s: %s", location(err.file, err.line), err.RuleError.Error())
+}
+
+func newRuleFileError(err RuleError, file string, line int) RuleError {
+	return ruleFileError{err, file, line}
+}
+
+// location formats the position in a file, omitting the line if it's unknown.
+func location(file string, line int) string {
+	if line == 0 {
+		return file
+	}
+	return fmt.Sprintf("%s:%d", file, line)
+}
 
 func (err conversionError) Code() ConversionErrorCode {
 	return err.code
@@ -108,10 +179,32 @@ func newUnusedTag(tag string) ConversionError {
 	}
 }
 
-func newCannotInterpolate(m interface{}) ConversionError {
+func newCannotInterpolateReason(reason string) ConversionError {
+	return conversionError{
+		CannotInterpolate,
+		fmt.Sprintf("Cannot interpolate, %s", reason),
+	}
+}
+
+func newMetricKeyMismatch(metricKey api.MetricKey, pattern string) ConversionError {
+	return conversionError{
+		MetricKeyMismatch,
+		fmt.Sprintf("Metric key '%s' does not match '%s'", metricKey, pattern),
+	}
+}
+
+// newCannotReverse is returned when no rule converts the metric back, describing why the closest rule failed.
+func newCannotReverse(metric api.TaggedMetric, closest *ConversionAttempt) ConversionError {
+	if closest == nil {
+		return conversionError{
+			CannotInterpolate,
+			fmt.Sprintf("No rule has a metric key matching '%s'", metric.MetricKey),
+		}
+	}
 	return conversionError{
 		CannotInterpolate,
-		fmt.Sprintf("Cannot interpolate %+v", m),
+		fmt.Sprintf("Cannot convert %s %s, closest rule '%s' (metric key '%s'): %s",
+			metric.MetricKey, metric.TagSet.Serialize(), closest.Rule.Pattern, closest.Rule.MetricKeyPattern, closest.Err.Error()),
 	}
 }
 
@@ -122,6 +215,42 @@ func newNoMatch() ConversionError {
 	}
 }
 
+type cardinalityError struct {
+	code    CardinalityErrorCode
+	metric  api.TaggedMetric
+	message string
+}
+
+func (err cardinalityError) Code() CardinalityErrorCode {
+	return err.code
+}
+
+func (err cardinalityError) Metric() api.TaggedMetric {
+	return err.metric
+}
+
+func (err cardinalityError) Error() string {
+	return err.message
+}
+
+func newTooManyTagSets(metric api.TaggedMetric, limit int) CardinalityError {
+	return cardinalityError{
+		TooManyTagSets,
+		metric,
+		fmt.Sprintf("Metric '%s' already has %d tagsets", metric.MetricKey, limit),
+	}
+}
+
+func newTooManyTagValues(metric api.TaggedMetric, tagKey string, limit int) CardinalityError {
+	return cardinalityError{
+		TooManyTagValues,
+		metric,
+		fmt.Sprintf("Tag '%s' already has %d values", tagKey, limit),
+	}
+}
+
 // ensure interface
 var _ RuleError = (*ruleError)(nil)
+var _ RuleError = (*ruleFileError)(nil)
 var _ ConversionError = (*conversionError)(nil)
+var _ CardinalityError = (*cardinalityError)(nil)
@@ -0,0 +1,301 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strings"
+)
+
+// maxLintSamples bounds the number of sample metrics generated for each rule.
+const maxLintSamples = 8
+
+// sampleRunes are tried, in order, as the characters of generated tag values.
+// They are unusual enough to rarely collide with the literal parts of patterns.
+const sampleRunes = "qzx0189_-"
+
+// LintCode is the kind of problem found in a rule set by Lint.
+type LintCode int
+
+const (
+	// ShadowedRule is reported when every metric matched by a rule is matched by earlier rules.
+	ShadowedRule LintCode = iota + 1
+	// OverlappingRules is reported when some metrics are matched by both a rule and an earlier rule.
+	OverlappingRules
+	// IrreversibleRule is reported when converting a metric back to graphite does not give the original metric.
+	IrreversibleRule
+)
+
+// LintWarning describes a problem found in a rule set.
+type LintWarning struct {
+	Code    LintCode
+	Rule    int    // index of the rule with the problem
+	Other   int    // index of the other rule involved, or -1
+	Sample  string // graphite metric showing the problem
+	Message string
+}
+
+func (warning LintWarning) String() string {
+	return warning.Message
+}
+
+// Lint checks the rules for shadowed, overlapping and irreversible rules.
+// Sample metrics are generated from the pattern of each rule, so problems are found heuristically.
+func (ruleSet RuleSet) Lint() []LintWarning {
+	warnings := []LintWarning{}
+	samples := make([][]string, len(ruleSet.rules))
+	for i, rule := range ruleSet.rules {
+		samples[i] = rule.samples()
+	}
+	for i, rule := range ruleSet.rules {
+		// claims holds, for each sample, the earlier rule matching it, or -1.
+		claims := make([]int, len(samples[i]))
+		shadowed := len(samples[i]) > 0
+		for index, sample := range samples[i] {
+			claims[index] = ruleSet.firstMatch(sample, i)
+			if claims[index] == -1 {
+				shadowed = false
+			}
+		}
+		if shadowed {
+			warnings = append(warnings, LintWarning{
+				Code:    ShadowedRule,
+				Rule:    i,
+				Other:   claims[0],
+				Sample:  samples[i][0],
+				Message: fmt.Sprintf("rule %s is shadowed by earlier rules, e.g. '%s' is matched by rule %s", rule.describe(i), samples[i][0], ruleSet.rules[claims[0]].describe(claims[0])),
+			})
+			continue
+		}
+		for j := 0; j < i; j++ {
+			if sample, ok := ruleSet.overlap(i, j, samples, claims); ok {
+				warnings = append(warnings, LintWarning{
+					Code:    OverlappingRules,
+					Rule:    i,
+					Other:   j,
+					Sample:  sample,
+					Message: fmt.Sprintf("rule %s overlaps with earlier rule %s, e.g. '%s'", rule.describe(i), ruleSet.rules[j].describe(j), sample),
+				})
+			}
+		}
+		for index, sample := range samples[i] {
+			if claims[index] != -1 {
+				continue // the metric is converted by an earlier rule.
+			}
+			if warning, ok := ruleSet.checkReversible(i, sample); ok {
+				warnings = append(warnings, warning)
+				break
+			}
+		}
+	}
+	return warnings
+}
+
+// firstMatch returns the index of the first rule before the given rule matching the sample, or -1.
+func (ruleSet RuleSet) firstMatch(sample string, before int) int {
+	for j := 0; j < before; j++ {
+		if ruleSet.rules[j].graphitePatternRegex.MatchString(sample) {
+			return j
+		}
+	}
+	return -1
+}
+
+// overlap finds a sample of either rule which is matched by both.
+func (ruleSet RuleSet) overlap(i, j int, samples [][]string, claims []int) (string, bool) {
+	for index, sample := range samples[i] {
+		if claims[index] == j {
+			return sample, true
+		}
+	}
+	for _, sample := range samples[j] {
+		if ruleSet.rules[i].graphitePatternRegex.MatchString(sample) {
+			return sample, true
+		}
+	}
+	return "", false
+}
+
+// checkReversible converts the sample with the rule, and checks that the rule set converts it back to the sample.
+func (ruleSet RuleSet) checkReversible(i int, sample string) (LintWarning, bool) {
+	rule := ruleSet.rules[i]
+	if rule.raw.OneWay {
+		return LintWarning{}, false
+	}
+	converted, matched := rule.MatchRule(sample)
+	if !matched {
+		return LintWarning{}, false
+	}
+	for k, other := range ruleSet.rules {
+		reversed, err := other.ToGraphiteName(converted)
+		if err != nil {
+			continue
+		}
+		if string(reversed) == sample {
+			return LintWarning{}, false
+		}
+		return LintWarning{
+			Code:    IrreversibleRule,
+			Rule:    i,
+			Other:   k,
+			Sample:  sample,
+			Message: fmt.Sprintf("rule %s is not reversible, '%s' is converted back to '%s' by rule %s", rule.describe(i), sample, reversed, other.describe(k)),
+		}, true
+	}
+	return LintWarning{
+		Code:    IrreversibleRule,
+		Rule:    i,
+		Other:   -1,
+		Sample:  sample,
+		Message: fmt.Sprintf("rule %s is not reversible, '%s' cannot be converted back", rule.describe(i), sample),
+	}, true
+}
+
+func (rule Rule) describe(index int) string {
+	return fmt.Sprintf("#%d '%s' (metric key '%s')", index, rule.raw.Pattern, rule.raw.MetricKeyPattern)
+}
+
+// samples generates graphite metrics matched by the rule, using sample values for each tag.
+func (rule Rule) samples() []string {
+	tagSamples := make(map[string][]string)
+	count := 1
+	for _, tag := range rule.graphitePatternTags {
+		regex, contains := rule.raw.Regex[tag]
+		if !contains {
+			regex = defaultRegex
+		}
+		parsed, err := syntax.Parse(regex, syntax.Perl)
+		if err != nil {
+			return nil
+		}
+		values := regexSamples(parsed.Simplify())
+		if len(values) == 0 {
+			return nil
+		}
+		tagSamples[tag] = values
+		if len(values) > count {
+			count = len(values)
+		}
+	}
+	// Each value of each tag appears in at least one sample.
+	result := []string{}
+	seen := map[string]bool{}
+	for n := 0; n < count; n++ {
+		splitted := strings.Split(rule.raw.Pattern, "%")
+		for index, token := range splitted {
+			if isTagPortion(index) {
+				values := tagSamples[token]
+				splitted[index] = values[n%len(values)]
+			}
+		}
+		sample := strings.Join(splitted, "")
+		if !seen[sample] && rule.graphitePatternRegex.MatchString(sample) {
+			seen[sample] = true
+			result = append(result, sample)
+		}
+	}
+	return result
+}
+
+// regexSamples generates up to maxLintSamples strings matched by the regex, covering its alternatives.
+func regexSamples(regex *syntax.Regexp) []string {
+	switch regex.Op {
+	case syntax.OpLiteral:
+		return []string{string(regex.Rune)}
+	case syntax.OpCharClass:
+		return charClassSamples(regex.Rune)
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return []string{sampleRunes[:1], sampleRunes[1:2], sampleRunes[2:3]}
+	case syntax.OpCapture, syntax.OpPlus:
+		return regexSamples(regex.Sub[0])
+	case syntax.OpStar, syntax.OpQuest:
+		return limitSamples(append([]string{""}, regexSamples(regex.Sub[0])...))
+	case syntax.OpRepeat:
+		if regex.Max == 0 {
+			return []string{""}
+		}
+		result := []string{}
+		times := regex.Min
+		if times == 0 {
+			result = append(result, "")
+			times = 1
+		}
+		for _, sample := range regexSamples(regex.Sub[0]) {
+			result = append(result, strings.Repeat(sample, times))
+		}
+		return limitSamples(result)
+	case syntax.OpConcat:
+		result := []string{""}
+		for _, sub := range regex.Sub {
+			next := []string{}
+			for _, prefix := range result {
+				for _, suffix := range regexSamples(sub) {
+					next = append(next, prefix+suffix)
+				}
+			}
+			result = limitSamples(next)
+		}
+		return result
+	case syntax.OpAlternate:
+		result := []string{}
+		for _, sub := range regex.Sub {
+			result = append(result, regexSamples(sub)...)
+		}
+		return limitSamples(result)
+	case syntax.OpNoMatch:
+		return nil
+	default:
+		// empty matches, and anchors.
+		return []string{""}
+	}
+}
+
+// charClassSamples picks up to three characters in the class, given as pairs of bounds.
+func charClassSamples(ranges []rune) []string {
+	result := []string{}
+	for _, candidate := range sampleRunes {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if ranges[i] <= candidate && candidate <= ranges[i+1] {
+				result = append(result, string(candidate))
+				break
+			}
+		}
+		if len(result) == 3 {
+			return result
+		}
+	}
+	if len(result) == 0 && len(ranges) > 0 {
+		result = append(result, string(ranges[0]))
+	}
+	return result
+}
+
+// limitSamples removes duplicate samples, keeping at most maxLintSamples.
+func limitSamples(samples []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, sample := range samples {
+		if seen[sample] {
+			continue
+		}
+		seen[sample] = true
+		result = append(result, sample)
+		if len(result) == maxLintSamples {
+			break
+		}
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"testing"
+
+	"github.com/square/metrics/assert"
+)
+
+func compileRuleSet(a assert.Assert, rawRules ...RawRule) RuleSet {
+	rules := make([]Rule, len(rawRules))
+	for i, rawRule := range rawRules {
+		rule, err := Compile(rawRule)
+		a.CheckError(err)
+		rules[i] = rule
+	}
+	return newRuleSet(rules)
+}
+
+func TestLint(t *testing.T) {
+	for _, test := range []struct {
+		rules    []RawRule
+		expected []LintWarning // only Code, Rule and Other are compared.
+	}{
+		{
+			[]RawRule{
+				{Pattern: "foo.%app%", MetricKeyPattern: "foo"},
+				{Pattern: "bar.%app%", MetricKeyPattern: "bar"},
+			},
+			[]LintWarning{},
+		},
+		{
+			[]RawRule{
+				{Pattern: "foo.%app%", MetricKeyPattern: "foo"},
+				{Pattern: "foo.%host%", MetricKeyPattern: "bar"},
+			},
+			[]LintWarning{{Code: ShadowedRule, Rule: 1, Other: 0}},
+		},
+		{
+			[]RawRule{
+				{Pattern: "foo.%app%.count", MetricKeyPattern: "foo"},
+				{Pattern: "foo.%app%.%name%", MetricKeyPattern: "foo-%name%"},
+			},
+			[]LintWarning{{Code: OverlappingRules, Rule: 1, Other: 0}},
+		},
+		{
+			[]RawRule{
+				{Pattern: "foo.%shard%", MetricKeyPattern: "foo", Regex: map[string]string{"shard": "[0-9]+"}},
+				{Pattern: "foo.%app%", MetricKeyPattern: "bar"},
+			},
+			[]LintWarning{{Code: OverlappingRules, Rule: 1, Other: 0}},
+		},
+		{
+			[]RawRule{
+				{Pattern: "bar.%app%", MetricKeyPattern: "foo"},
+				{Pattern: "baz.%app%", MetricKeyPattern: "foo"},
+			},
+			[]LintWarning{{Code: IrreversibleRule, Rule: 1, Other: 0}},
+		},
+	} {
+		a := assert.New(t).Contextf("%+v", test.rules)
+		warnings := compileRuleSet(a, test.rules...).Lint()
+		a.EqInt(len(warnings), len(test.expected))
+		for i := range test.expected {
+			if i >= len(warnings) {
+				break
+			}
+			a.Eq(warnings[i].Code, test.expected[i].Code)
+			a.EqInt(warnings[i].Rule, test.expected[i].Rule)
+			a.EqInt(warnings[i].Other, test.expected[i].Other)
+		}
+	}
+}
+
+func Test_regexSamples(t *testing.T) {
+	for _, regex := range []string{
+		defaultRegex,
+		"[0-9]+",
+		"(?:prod|staging)-[a-c]{2,3}",
+		"x*y?",
+		".",
+	} {
+		a := assert.New(t).Contextf("%s", regex)
+		parsed, err := syntax.Parse(regex, syntax.Perl)
+		a.CheckError(err)
+		samples := regexSamples(parsed.Simplify())
+		if len(samples) == 0 {
+			a.Errorf("Expected samples")
+		}
+		compiled := regexp.MustCompile("^(?:" + regex + ")$")
+		for _, sample := range samples {
+			if !compiled.MatchString(sample) {
+				a.Errorf("Sample '%s' is not matched", sample)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"github.com/square/metrics/api"
+)
+
+// BackfillTagKeySet adds the tag keys of the tag index to tag_key_set, which is only filled
+// as metrics are added. It returns the number of keys found.
+// It is safe to run while metrics are being added, and to run more than once.
+func BackfillTagKeySet(config api.Config) (int, error) {
+	database, err := NewCassandraDatabase(newClusterConfig(config))
+	if err != nil {
+		return 0, err
+	}
+	db := database.(*defaultDatabase)
+	defer db.session.Close()
+	return db.backfillTagKeySet()
+}
+
+func (db *defaultDatabase) backfillTagKeySet() (int, error) {
+	var tagKey string
+	count := 0
+	iterator := db.session.Query("SELECT DISTINCT tag_key FROM tag_index").Iter()
+	for iterator.Scan(&tagKey) {
+		if err := db.addTagKey(tagKey); err != nil {
+			iterator.Close()
+			return count, err
+		}
+		count++
+	}
+	return count, iterator.Close()
+}
+
+// BackfillTagSetIndex adds every tagset of metric_names to tag_set_index, which is only filled
+// as metrics are added. It returns the number of tagsets indexed.
+// It is safe to run while metrics are being added, and to run more than once.
+func BackfillTagSetIndex(config api.Config) (int, error) {
+	database, err := NewCassandraDatabase(newClusterConfig(config))
+	if err != nil {
+		return 0, err
+	}
+	db := database.(*defaultDatabase)
+	defer db.session.Close()
+	return db.backfillTagSetIndex()
+}
+
+func (db *defaultDatabase) backfillTagSetIndex() (int, error) {
+	var metricKey string
+	rawTag := ""
+	count := 0
+	iterator := db.session.Query("SELECT metric_key, tag_set FROM metric_names").Iter()
+	for iterator.Scan(&metricKey, &rawTag) {
+		tagSet := api.ParseTagSet(rawTag)
+		if tagSet == nil {
+			continue
+		}
+		if err := db.addToTagSetIndex(api.MetricKey(metricKey), tagSet); err != nil {
+			iterator.Close()
+			return count, err
+		}
+		count++
+	}
+	return count, iterator.Close()
+}
@@ -0,0 +1,160 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// fileRule is a rule along with where it was read.
+type fileRule struct {
+	raw  RawRule
+	file string
+	line int // zero if unknown.
+}
+
+// ruleLoader reads rule files and the files they include.
+type ruleLoader struct {
+	rules   []fileRule
+	loaded  map[string]bool // files which were read, by absolute path.
+	loading map[string]bool // files whose includes are being read, by absolute path.
+}
+
+// LoadRules loads a RuleSet from the rule YAML files at the given path, which is a file,
+// a directory holding .yaml and .yml files, or a glob. The files are read in the order of their names,
+// and the files included by each file are read after it.
+// error is an interface of RuleError.
+func LoadRules(path string) (RuleSet, error) {
+	files, err := expandRulePaths(path)
+	if err != nil {
+		return RuleSet{}, err
+	}
+	loader := ruleLoader{loaded: make(map[string]bool), loading: make(map[string]bool)}
+	for _, file := range files {
+		if err := loader.load(file); err != nil {
+			return RuleSet{}, err
+		}
+	}
+	if err := loader.checkDuplicates(); err != nil {
+		return RuleSet{}, err
+	}
+	rules := make([]Rule, len(loader.rules))
+	for index, fileRule := range loader.rules {
+		rule, err := Compile(fileRule.raw)
+		if err != nil {
+			return RuleSet{}, newRuleFileError(err.(RuleError), fileRule.file, fileRule.line)
+		}
+		rules[index] = rule
+	}
+	return newRuleSet(rules), nil
+}
+
+// expandRulePaths lists the rule files at the given path.
+func expandRulePaths(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err == nil && !info.IsDir() {
+		return []string{path}, nil
+	}
+	var files []string
+	if err == nil {
+		for _, extension := range []string{"*.yaml", "*.yml"} {
+			matches, _ := filepath.Glob(filepath.Join(path, extension))
+			files = append(files, matches...)
+		}
+	} else {
+		files, _ = filepath.Glob(path)
+	}
+	if len(files) == 0 {
+		return nil, newInvalidInclude(path, "no rule files found")
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (loader *ruleLoader) load(file string) error {
+	absolute, err := filepath.Abs(file)
+	if err != nil {
+		return newInvalidInclude(file, err.Error())
+	}
+	if loader.loading[absolute] {
+		return newInvalidInclude(file, "it includes itself")
+	}
+	if loader.loaded[absolute] {
+		return nil // included by several files.
+	}
+	loader.loaded[absolute] = true
+	input, err := ioutil.ReadFile(file)
+	if err != nil {
+		return newInvalidInclude(file, err.Error())
+	}
+	rawRules, err := parseRawRules(input)
+	if err != nil {
+		return newRuleFileError(err.(RuleError), file, 0)
+	}
+	lines := ruleLines(input)
+	if len(lines) != len(rawRules.RawRules) {
+		lines = make([]int, len(rawRules.RawRules))
+	}
+	for index, rawRule := range rawRules.RawRules {
+		loader.rules = append(loader.rules, fileRule{rawRule, file, lines[index]})
+	}
+	loader.loading[absolute] = true
+	defer delete(loader.loading, absolute)
+	for _, include := range rawRules.Include {
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(file), include)
+		}
+		if err := loader.load(include); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkDuplicates reports metric key patterns used by rules in different files.
+func (loader *ruleLoader) checkDuplicates() error {
+	first := make(map[string]fileRule)
+	for _, rule := range loader.rules {
+		metricKey := rule.raw.MetricKeyPattern
+		previous, ok := first[metricKey]
+		if !ok {
+			first[metricKey] = rule
+			continue
+		}
+		if previous.file != rule.file {
+			return newRuleFileError(newDuplicateMetricKey(metricKey, previous.file, previous.line), rule.file, rule.line)
+		}
+	}
+	return nil
+}
+
+// ruleLines finds the line of each rule in the YAML file, by the lines setting a pattern.
+func ruleLines(input []byte) []int {
+	lines := []int{}
+	scanner := bufio.NewScanner(bytes.NewReader(input))
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimLeft(scanner.Text(), " \t-")
+		if strings.HasPrefix(line, "pattern:") {
+			lines = append(lines, number)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,117 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func writeRuleFiles(a assert.Assert, files map[string]string) string {
+	directory, err := ioutil.TempDir("", "rules")
+	a.CheckError(err)
+	for name, content := range files {
+		a.CheckError(ioutil.WriteFile(filepath.Join(directory, name), []byte(content), 0644))
+	}
+	return directory
+}
+
+func Test_expandRulePaths(t *testing.T) {
+	a := assert.New(t)
+	directory := writeRuleFiles(a, map[string]string{"b.yaml": "", "a.yml": "", "c.txt": ""})
+	defer os.RemoveAll(directory)
+	for _, test := range []struct {
+		path     string
+		expected []string
+	}{
+		{directory, []string{"a.yml", "b.yaml"}},
+		{filepath.Join(directory, "*.yaml"), []string{"b.yaml"}},
+		{filepath.Join(directory, "c.txt"), []string{"c.txt"}},
+	} {
+		a := a.Contextf("%s", test.path)
+		files, err := expandRulePaths(test.path)
+		a.CheckError(err)
+		expected := []string{}
+		for _, name := range test.expected {
+			expected = append(expected, filepath.Join(directory, name))
+		}
+		a.Eq(files, expected)
+	}
+	_, err := expandRulePaths(filepath.Join(directory, "missing.yaml"))
+	checkRuleErrorCode(a, err, InvalidInclude)
+}
+
+func Test_ruleLines(t *testing.T) {
+	a := assert.New(t)
+	a.Eq(ruleLines([]byte(`
+namespace: team
+rules:
+  -
+    pattern: foo.%app%
+    metric_key: foo
+  - pattern: bar.%app%
+    metric_key: bar
+`)), []int{5, 7})
+}
+
+func Test_checkDuplicates(t *testing.T) {
+	a := assert.New(t)
+	loader := ruleLoader{rules: []fileRule{
+		{RawRule{Pattern: "foo.%app%", MetricKeyPattern: "foo"}, "a.yaml", 3},
+		{RawRule{Pattern: "bar.%app%", MetricKeyPattern: "foo"}, "a.yaml", 6},
+	}}
+	// Rules in the same file may share a metric key.
+	a.CheckError(loader.checkDuplicates())
+	loader.rules = append(loader.rules, fileRule{RawRule{Pattern: "baz.%app%", MetricKeyPattern: "foo"}, "b.yaml", 4})
+	err := loader.checkDuplicates()
+	checkRuleErrorCode(a, err, DuplicateMetricKey)
+	if err != nil {
+		a.EqString(err.Error(), "b.yaml:4: Metric key 'foo' is also used at a.yaml:3")
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	a := assert.New(t)
+	directory := writeRuleFiles(a, map[string]string{
+		"main.yaml": `
+include:
+  - teams/payments.yaml
+rules:
+  -
+    pattern: foo.%app%
+    metric_key: foo
+`,
+	})
+	defer os.RemoveAll(directory)
+	a.CheckError(os.Mkdir(filepath.Join(directory, "teams"), 0755))
+	a.CheckError(ioutil.WriteFile(filepath.Join(directory, "teams", "payments.yaml"), []byte(`
+namespace: payments
+rules:
+  -
+    pattern: payments.%app%
+    metric_key: latency
+`), 0644))
+	ruleSet, err := LoadRules(directory)
+	a.CheckError(err)
+	converted, matched := ruleSet.MatchRule("payments.server")
+	a.EqBool(matched, true)
+	a.EqString(string(converted.MetricKey), "payments.latency")
+	a.Eq(converted.TagSet, api.ParseTagSet("app=server"))
+}
@@ -16,6 +16,7 @@ package internal
 
 import (
 	"bytes"
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -30,11 +31,29 @@ type RawRule struct {
 	Pattern          string            `yaml:"pattern"`
 	MetricKeyPattern string            `yaml:"metric_key"`
 	Regex            map[string]string `yaml:"regex,omitempty"`
+	Examples         []RawExample      `yaml:"examples,omitempty"`
+	// Tags are added to every metric converted by the rule.
+	Tags map[string]string `yaml:"tags,omitempty"`
+	// Transforms change the values of the extracted tags, by tag.
+	Transforms map[string]RawTransform `yaml:"transforms,omitempty"`
+	// OneWay rules only convert graphite metrics to tagged metrics, and allow transforms which can't be reversed.
+	OneWay bool `yaml:"one_way,omitempty"`
+}
+
+// RawExample is a graphite metric, along with the tagged metric the rule is expected to convert it to.
+type RawExample struct {
+	Graphite  string            `yaml:"graphite"`
+	MetricKey string            `yaml:"metric_key"`
+	Tags      map[string]string `yaml:"tags,omitempty"`
 }
 
 // RawRules is list of RawRule
 type RawRules struct {
 	RawRules []RawRule `yaml:"rules"`
+	// Include lists files, relative to this one, whose rules are matched after these. Only used by LoadRules.
+	Include []string `yaml:"include,omitempty"`
+	// Namespace is prefixed to the metric keys of these rules, and of their examples.
+	Namespace string `yaml:"namespace,omitempty"`
 }
 
 // Rule is a sanitized version of RawRule. Only valid rules
@@ -45,12 +64,18 @@ type Rule struct {
 	metricKeyRegex       *regexp.Regexp
 	graphitePatternTags  []string // tags extracted from the raw graphite string, in the order of appearance.
 	metricKeyTags        []string // tags extracted from MetricKey, in the order of appearance.
+	transforms           map[string]transform
 }
 
 // RuleSet is a sanitized version of RawRules.
 // Rules are matched sequentially until a correct one is matched.
 type RuleSet struct {
 	rules []Rule
+	trie  *ruleTrie // index of the rules; if nil, every rule is tried.
+}
+
+func newRuleSet(rules []Rule) RuleSet {
+	return RuleSet{rules: rules, trie: newRuleTrie(rules)}
 }
 
 // Compile a given RawRule into a regex and exposed tagset.
@@ -73,6 +98,10 @@ func Compile(rule RawRule) (Rule, error) {
 	if !rule.checkTagRegexes() {
 		return Rule{}, newInvalidCustomRegex(rule.MetricKeyPattern)
 	}
+	transforms, err := rule.compileTransforms(graphitePatternTags)
+	if err != nil {
+		return Rule{}, err
+	}
 	regex := rule.toRegexp(rule.Pattern)
 	if regex == nil {
 		return Rule{}, newInvalidPattern(rule.MetricKeyPattern)
@@ -80,7 +109,7 @@ func Compile(rule RawRule) (Rule, error) {
 	if regex.NumSubexp() != len(graphitePatternTags) {
 		return Rule{}, newInvalidCustomRegex(rule.MetricKeyPattern)
 	}
-	metricKeyRegex := rule.toRegexp(rule.MetricKeyPattern)
+	metricKeyRegex := rule.metricKeyRule().toRegexp(rule.MetricKeyPattern)
 	if metricKeyRegex == nil {
 		return Rule{}, newInvalidPattern(rule.MetricKeyPattern)
 	}
@@ -93,6 +122,7 @@ func Compile(rule RawRule) (Rule, error) {
 		metricKeyRegex:       metricKeyRegex,
 		graphitePatternTags:  graphitePatternTags,
 		metricKeyTags:        metricKeyTags,
+		transforms:           transforms,
 	}, nil
 }
 
@@ -102,6 +132,9 @@ func (rule Rule) MatchRule(input string) (api.TaggedMetric, bool) {
 	if tagSet == nil {
 		return api.TaggedMetric{}, false
 	}
+	if !applyTransforms(rule.transforms, tagSet) {
+		return api.TaggedMetric{}, false
+	}
 	interpolatedKey, err := interpolateTags(rule.raw.MetricKeyPattern, tagSet, false)
 	if err != nil {
 		return api.TaggedMetric{}, false
@@ -118,6 +151,9 @@ func (rule Rule) MatchRule(input string) (api.TaggedMetric, bool) {
 			delete(tagSet, metricKeyTag)
 		}
 	}
+	for tagKey, tagValue := range rule.raw.Tags {
+		tagSet[tagKey] = tagValue
+	}
 	return api.TaggedMetric{
 		api.MetricKey(interpolatedKey),
 		tagSet,
@@ -129,12 +165,29 @@ func (rule Rule) ToGraphiteName(taggedMetric api.TaggedMetric) (api.GraphiteMetr
 	extractedTagSet := extractTagValues(rule.metricKeyRegex, rule.metricKeyTags, string(taggedMetric.MetricKey))
 	if extractedTagSet == nil {
 		// no match found. not a correct rule to interpolate.
-		return "", newCannotInterpolate(taggedMetric)
+		return "", newMetricKeyMismatch(taggedMetric.MetricKey, rule.raw.MetricKeyPattern)
+	}
+	if rule.raw.OneWay {
+		return "", newCannotInterpolateReason("the rule is one-way")
+	}
+	// The static tags must all be present, and are not part of the graphite metric.
+	tagSet := api.NewTagSet()
+	for tagKey, tagValue := range taggedMetric.TagSet {
+		tagSet[tagKey] = tagValue
+	}
+	for tagKey, tagValue := range rule.raw.Tags {
+		if value, present := tagSet[tagKey]; !present || value != tagValue {
+			return "", newCannotInterpolateReason(fmt.Sprintf("tag '%s' is not '%s'", tagKey, tagValue))
+		}
+		delete(tagSet, tagKey)
 	}
 	// Merge the tags in the provided tag set, and tags extracted from the metric.
 	// This is necessary because tags embedded in the metric are not
 	// exported to the tagset.
-	mergedTagSet := taggedMetric.TagSet.Merge(extractedTagSet)
+	mergedTagSet := tagSet.Merge(extractedTagSet)
+	if !reverseTransforms(rule.transforms, mergedTagSet) {
+		return "", newCannotInterpolateReason("a tag value cannot be mapped back")
+	}
 	interpolated, err := interpolateTags(rule.raw.Pattern, mergedTagSet, true)
 	if err != nil {
 		return "", err
@@ -145,25 +198,84 @@ func (rule Rule) ToGraphiteName(taggedMetric api.TaggedMetric) (api.GraphiteMetr
 // MatchRule sees if a given graphite string matches
 // any of the specified rules.
 func (ruleSet RuleSet) MatchRule(input string) (api.TaggedMetric, bool) {
-	for _, rule := range ruleSet.rules {
+	value, _, matched := ruleSet.MatchRuleIndex(input)
+	return value, matched
+}
+
+// MatchRuleIndex is like MatchRule, and also returns the index of the matching rule.
+func (ruleSet RuleSet) MatchRuleIndex(input string) (api.TaggedMetric, int, bool) {
+	if ruleSet.trie == nil {
+		return ruleSet.matchRuleLinear(input)
+	}
+	for _, index := range ruleSet.trie.candidates(input) {
+		value, matched := ruleSet.rules[index].MatchRule(input)
+		if matched {
+			return value, index, matched
+		}
+	}
+	return api.TaggedMetric{}, -1, false
+}
+
+// Rules returns the raw rules, in the order they are matched.
+func (ruleSet RuleSet) Rules() []RawRule {
+	rules := make([]RawRule, len(ruleSet.rules))
+	for index, rule := range ruleSet.rules {
+		rules[index] = rule.raw
+	}
+	return rules
+}
+
+// matchRuleLinear tries every rule in order.
+func (ruleSet RuleSet) matchRuleLinear(input string) (api.TaggedMetric, int, bool) {
+	for index, rule := range ruleSet.rules {
 		value, matched := rule.MatchRule(input)
 		if matched {
-			return value, matched
+			return value, index, matched
 		}
 	}
-	return api.TaggedMetric{}, false
+	return api.TaggedMetric{}, -1, false
 }
 
 // ToGraphiteName transforms the given tagged metric back to its graphite name,
-// checking against all the rules.
+// checking against all the rules. If no rule can, the error describes why the closest rule failed.
 func (ruleSet RuleSet) ToGraphiteName(taggedMetric api.TaggedMetric) (api.GraphiteMetric, error) {
-	for _, rule := range ruleSet.rules {
+	reversed, attempts := ruleSet.ExplainToGraphiteName(taggedMetric)
+	if len(attempts) < len(ruleSet.rules) {
+		return reversed, nil
+	}
+	return "", newCannotReverse(taggedMetric, closestAttempt(attempts))
+}
+
+// ConversionAttempt is a rule which failed to convert a tagged metric back to its graphite name.
+type ConversionAttempt struct {
+	Index int // of the rule
+	Rule  RawRule
+	Err   ConversionError
+}
+
+// ExplainToGraphiteName converts the tagged metric like ToGraphiteName,
+// and also returns the rules which failed before one succeeded, with their errors.
+// If every rule fails, the returned graphite name is empty.
+func (ruleSet RuleSet) ExplainToGraphiteName(taggedMetric api.TaggedMetric) (api.GraphiteMetric, []ConversionAttempt) {
+	attempts := []ConversionAttempt{}
+	for index, rule := range ruleSet.rules {
 		reversed, err := rule.ToGraphiteName(taggedMetric)
 		if err == nil {
-			return reversed, nil
+			return reversed, attempts
+		}
+		attempts = append(attempts, ConversionAttempt{index, rule.raw, err.(ConversionError)})
+	}
+	return "", attempts
+}
+
+// closestAttempt returns the first attempt by a rule whose metric key matched, or nil.
+func closestAttempt(attempts []ConversionAttempt) *ConversionAttempt {
+	for index := range attempts {
+		if attempts[index].Err.Code() != MetricKeyMismatch {
+			return &attempts[index]
 		}
 	}
-	return "", newCannotInterpolate(taggedMetric)
+	return nil
 }
 
 // checkTagRegexes sees if any of the custom regular expressions are invalid.
@@ -261,12 +373,12 @@ func extractTags(pattern string) []string {
 // LoadYAML loads a RuleSet from the byte array of the YAML file.
 // error is an interface of RuleError.
 func LoadYAML(input []byte) (RuleSet, error) {
-	rawRules := RawRules{}
-	if err := yaml.Unmarshal(input, &rawRules); err != nil {
-		return RuleSet{}, ruleError{
-			code:    InvalidYaml,
-			message: err.Error(),
-		}
+	rawRules, err := parseRawRules(input)
+	if err != nil {
+		return RuleSet{}, err
+	}
+	if len(rawRules.Include) > 0 {
+		return RuleSet{}, newInvalidInclude(rawRules.Include[0], "includes are only read from files")
 	}
 	rules := make([]Rule, len(rawRules.RawRules))
 	for index, rawRule := range rawRules.RawRules {
@@ -276,7 +388,87 @@ func LoadYAML(input []byte) (RuleSet, error) {
 		}
 		rules[index] = rule
 	}
-	return RuleSet{rules}, nil
+	return newRuleSet(rules), nil
+}
+
+// parseRawRules parses the YAML file, prefixing the metric keys with the namespace.
+func parseRawRules(input []byte) (RawRules, error) {
+	rawRules := RawRules{}
+	if err := yaml.Unmarshal(input, &rawRules); err != nil {
+		return RawRules{}, ruleError{
+			code:    InvalidYaml,
+			message: err.Error(),
+		}
+	}
+	if rawRules.Namespace != "" {
+		for index := range rawRules.RawRules {
+			rule := &rawRules.RawRules[index]
+			rule.MetricKeyPattern = rawRules.Namespace + "." + rule.MetricKeyPattern
+			for example := range rule.Examples {
+				rule.Examples[example].MetricKey = rawRules.Namespace + "." + rule.Examples[example].MetricKey
+			}
+		}
+	}
+	return rawRules, nil
+}
+
+// LoadYAMLStrict loads a RuleSet like LoadYAML, and checks the examples of each rule.
+// error is an interface of RuleError.
+func LoadYAMLStrict(input []byte) (RuleSet, error) {
+	ruleSet, err := LoadYAML(input)
+	if err != nil {
+		return RuleSet{}, err
+	}
+	if errors := ruleSet.CheckExamples(); len(errors) > 0 {
+		return RuleSet{}, errors[0]
+	}
+	return ruleSet, nil
+}
+
+// CheckExamples checks that the rule set converts each example of each rule
+// to the expected tagged metric, and converts it back to the example.
+func (ruleSet RuleSet) CheckExamples() []RuleError {
+	errors := []RuleError{}
+	for index, rule := range ruleSet.rules {
+		for _, example := range rule.raw.Examples {
+			if err := ruleSet.checkExample(index, example); err != nil {
+				errors = append(errors, err)
+			}
+		}
+	}
+	return errors
+}
+
+// checkExample converts the example with the rule at the given index, then converts it back with the rule set.
+// The example must not be matched by an earlier rule, since the rule would never convert it.
+func (ruleSet RuleSet) checkExample(index int, example RawExample) RuleError {
+	rule := ruleSet.rules[index]
+	metricKey := rule.raw.MetricKeyPattern
+	converted, matched := rule.MatchRule(example.Graphite)
+	if !matched {
+		return newInvalidExample(metricKey, example.Graphite, "it is not matched")
+	}
+	if _, first, _ := ruleSet.MatchRuleIndex(example.Graphite); first != index {
+		return newInvalidExample(metricKey, example.Graphite, fmt.Sprintf("it is matched by the earlier rule %s", ruleSet.rules[first].describe(first)))
+	}
+	expected := api.TagSet(example.Tags)
+	if expected == nil {
+		expected = api.NewTagSet()
+	}
+	if string(converted.MetricKey) != example.MetricKey || !converted.TagSet.Equals(expected) {
+		return newInvalidExample(metricKey, example.Graphite, fmt.Sprintf("it is converted to %s %s", converted.MetricKey, converted.TagSet.Serialize()))
+	}
+	if rule.raw.OneWay {
+		return nil
+	}
+	reversed, err := ruleSet.ToGraphiteName(converted)
+	if err != nil {
+		return newInvalidExample(metricKey, example.Graphite, "it cannot be converted back")
+	}
+	if string(reversed) != example.Graphite {
+		return newInvalidExample(metricKey, example.Graphite, fmt.Sprintf("it is converted back to '%s'", reversed))
+	}
+	return nil
 }
 
 // check if setA is subset of setB.
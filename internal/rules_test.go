@@ -207,7 +207,7 @@ func TestToGraphiteName_Error(t *testing.T) {
 		MetricKey: "test-metric-foo",
 		TagSet:    api.ParseTagSet("foo=fooValue"),
 	})
-	checkConversionErrorCode(t, err, CannotInterpolate)
+	checkConversionErrorCode(t, err, MetricKeyMismatch)
 	a.EqString(string(reversed), "")
 }
 
@@ -256,3 +256,171 @@ func Test_interpolateTags(t *testing.T) {
 	}
 
 }
+
+func TestCheckExamples(t *testing.T) {
+	a := assert.New(t)
+	ruleSet := compileRuleSet(a, RawRule{
+		Pattern:          "foo.%app%.%name%",
+		MetricKeyPattern: "foo.%name%",
+		Examples: []RawExample{
+			{Graphite: "foo.server.latency", MetricKey: "foo.latency", Tags: map[string]string{"app": "server"}},
+			// not matched
+			{Graphite: "bar.server.latency", MetricKey: "foo.latency", Tags: map[string]string{"app": "server"}},
+			// wrong metric key
+			{Graphite: "foo.server.latency", MetricKey: "foo", Tags: map[string]string{"app": "server"}},
+			// wrong tags
+			{Graphite: "foo.server.latency", MetricKey: "foo.latency"},
+		},
+	}, RawRule{
+		Pattern:          "bar.%app%.%name%",
+		MetricKeyPattern: "foo.%name%",
+		Examples: []RawExample{
+			// converted back by the first rule
+			{Graphite: "bar.server.latency", MetricKey: "foo.latency", Tags: map[string]string{"app": "server"}},
+		},
+	}, RawRule{
+		Pattern:          "foo.%app%.%name%",
+		MetricKeyPattern: "foo.%name%",
+		Regex:            map[string]string{"app": "server"},
+		Examples: []RawExample{
+			// converted the same way by the first rule, so this rule is never used
+			{Graphite: "foo.server.latency", MetricKey: "foo.latency", Tags: map[string]string{"app": "server"}},
+		},
+	})
+	errors := ruleSet.CheckExamples()
+	a.EqInt(len(errors), 5)
+	for _, err := range errors {
+		checkRuleErrorCode(a, err, InvalidExample)
+	}
+	if len(errors) == 5 {
+		a.EqString(errors[3].MetricKey(), "foo.%name%")
+		a.EqString(errors[3].Error(), "Invalid example 'bar.server.latency' in key 'foo.%name%': it is converted back to 'foo.server.latency'")
+		a.EqString(errors[4].Error(), "Invalid example 'foo.server.latency' in key 'foo.%name%': it is matched by the earlier rule #0 'foo.%app%.%name%' (metric key 'foo.%name%')")
+	}
+}
+
+func TestLoadYAMLStrict(t *testing.T) {
+	a := assert.New(t)
+	rawYAML := `
+rules:
+  -
+    pattern: foo.bar.baz.%tag%
+    metric_key: abc
+    examples:
+      -
+        graphite: foo.bar.baz.qux
+        metric_key: abc
+        tags:
+          tag: quux
+  `
+	_, err := LoadYAMLStrict([]byte(rawYAML))
+	checkRuleErrorCode(a, err, InvalidExample)
+}
+
+func TestCompile_Transforms(t *testing.T) {
+	for _, test := range []struct {
+		rawRule      RawRule
+		expectedCode RuleErrorCode // zero if the rule compiles
+	}{
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Map: map[string]string{"prod01": "production"}}}}, 0},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Lowercase: true}}, OneWay: true}, 0},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Lowercase: true}}}, InvalidTransform},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Replace: "[0-9]+", With: ""}}}, InvalidTransform},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Replace: "(", With: ""}}, OneWay: true}, InvalidTransform},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"env": {Map: map[string]string{"prod01": "production", "prod02": "production"}}}}, InvalidTransform},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Transforms: map[string]RawTransform{"host": {Lowercase: true}}, OneWay: true}, InvalidTransform},
+		{RawRule{Pattern: "prefix.%env%", MetricKeyPattern: "test-metric", Tags: map[string]string{"env": "production"}}, InvalidStaticTag},
+	} {
+		_, err := Compile(test.rawRule)
+		a := assert.New(t).Contextf("%+v", test.rawRule)
+		if test.expectedCode == 0 {
+			a.CheckError(err)
+		} else {
+			checkRuleErrorCode(a, err, test.expectedCode)
+		}
+	}
+}
+
+func TestMatchRule_Transforms(t *testing.T) {
+	a := assert.New(t)
+	rule, err := Compile(RawRule{
+		Pattern:          "prefix.%env%.%host%",
+		MetricKeyPattern: "test-metric.%env%",
+		Tags:             map[string]string{"source": "legacy"},
+		Transforms: map[string]RawTransform{
+			"env": {Map: map[string]string{"prod01": "production"}},
+		},
+	})
+	a.CheckError(err)
+	for _, test := range []struct {
+		input    string
+		expected api.TaggedMetric
+	}{
+		{"prefix.prod01.host1", api.TaggedMetric{MetricKey: "test-metric.production", TagSet: api.ParseTagSet("host=host1,source=legacy")}},
+		{"prefix.staging.host1", api.TaggedMetric{MetricKey: "test-metric.staging", TagSet: api.ParseTagSet("host=host1,source=legacy")}},
+	} {
+		a := a.Contextf("%s", test.input)
+		converted, matched := rule.MatchRule(test.input)
+		a.EqBool(matched, true)
+		a.Eq(converted, test.expected)
+		reversed, err := rule.ToGraphiteName(converted)
+		a.CheckError(err)
+		a.EqString(string(reversed), test.input)
+	}
+	// The result of the mapping can't be extracted as it is, since it would be reversed differently.
+	_, matched := rule.MatchRule("prefix.production.host1")
+	a.EqBool(matched, false)
+	// The static tags are required to reverse the metric.
+	_, err = rule.ToGraphiteName(api.TaggedMetric{MetricKey: "test-metric.production", TagSet: api.ParseTagSet("host=host1")})
+	checkConversionErrorCode(t, err, CannotInterpolate)
+	_, err = rule.ToGraphiteName(api.TaggedMetric{MetricKey: "test-metric.production", TagSet: api.ParseTagSet("host=host1,source=other")})
+	checkConversionErrorCode(t, err, CannotInterpolate)
+	// Values which are mapped are never left as they are.
+	_, err = rule.ToGraphiteName(api.TaggedMetric{MetricKey: "test-metric.prod01", TagSet: api.ParseTagSet("host=host1,source=legacy")})
+	checkConversionErrorCode(t, err, CannotInterpolate)
+}
+
+func TestMatchRule_OneWay(t *testing.T) {
+	a := assert.New(t)
+	rule, err := Compile(RawRule{
+		Pattern:          "prefix.%env%.%host%",
+		MetricKeyPattern: "test-metric",
+		Transforms: map[string]RawTransform{
+			"host": {Lowercase: true, Replace: "[0-9]+$", With: ""},
+		},
+		OneWay: true,
+	})
+	a.CheckError(err)
+	converted, matched := rule.MatchRule("prefix.prod.Host12")
+	a.EqBool(matched, true)
+	a.Eq(converted, api.TaggedMetric{MetricKey: "test-metric", TagSet: api.ParseTagSet("env=prod,host=host")})
+	_, err = rule.ToGraphiteName(converted)
+	checkConversionErrorCode(t, err, CannotInterpolate)
+}
+
+func TestExplainToGraphiteName(t *testing.T) {
+	a := assert.New(t)
+	ruleSet := compileRuleSet(a,
+		RawRule{Pattern: "foo.%app%", MetricKeyPattern: "foo"},
+		RawRule{Pattern: "bar.%app%.%host%", MetricKeyPattern: "bar"},
+		RawRule{Pattern: "bar.%app%", MetricKeyPattern: "bar"},
+	)
+	metric := api.TaggedMetric{MetricKey: "bar", TagSet: api.ParseTagSet("app=server")}
+	reversed, attempts := ruleSet.ExplainToGraphiteName(metric)
+	a.EqString(string(reversed), "bar.server")
+	a.EqInt(len(attempts), 2)
+	if len(attempts) == 2 {
+		a.EqInt(attempts[0].Index, 0)
+		checkConversionErrorCode(t, attempts[0].Err, MetricKeyMismatch)
+		a.EqInt(attempts[1].Index, 1)
+		checkConversionErrorCode(t, attempts[1].Err, MissingTag)
+	}
+
+	// The error of the closest rule is reported.
+	_, err := ruleSet.ToGraphiteName(api.TaggedMetric{MetricKey: "bar", TagSet: api.ParseTagSet("app=server,dc=east")})
+	checkConversionErrorCode(t, err, CannotInterpolate)
+	a.EqString(err.Error(), "Cannot convert bar app=server,dc=east, closest rule 'bar.%app%.%host%' (metric key 'bar'): Missing tag 'host'")
+	_, err = ruleSet.ToGraphiteName(api.TaggedMetric{MetricKey: "baz", TagSet: api.ParseTagSet("app=server")})
+	checkConversionErrorCode(t, err, CannotInterpolate)
+	a.EqString(err.Error(), "No rule has a metric key matching 'baz'")
+}
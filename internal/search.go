@@ -0,0 +1,106 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/square/metrics/api"
+)
+
+// searchIndexRefresh is how long the search index is used before it is reloaded from metric_name_set.
+const searchIndexRefresh = time.Minute
+
+// metricIndex is a sorted, in-memory copy of all metric names, used to search them.
+type metricIndex struct {
+	names   []string // sorted
+	updated time.Time
+	mutex   sync.RWMutex
+}
+
+func newMetricIndex() *metricIndex {
+	return &metricIndex{}
+}
+
+// stale returns true if the index should be reloaded.
+func (index *metricIndex) stale(now time.Time) bool {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+	return index.updated.IsZero() || now.Sub(index.updated) >= searchIndexRefresh
+}
+
+// replace sets the contents of the index to exactly the given keys.
+func (index *metricIndex) replace(keys []api.MetricKey, now time.Time) {
+	names := make([]string, len(keys))
+	for i, key := range keys {
+		names[i] = string(key)
+	}
+	sort.Strings(names)
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	index.names = names
+	index.updated = now
+}
+
+// add inserts a single key into the index, if it is not already present.
+func (index *metricIndex) add(key api.MetricKey) {
+	name := string(key)
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+	position := sort.SearchStrings(index.names, name)
+	if position < len(index.names) && index.names[position] == name {
+		return
+	}
+	index.names = append(index.names, "")
+	copy(index.names[position+1:], index.names[position:])
+	index.names[position] = name
+}
+
+// search returns up to limit metric names containing the query.
+// Names beginning with the query come first, followed by the other names containing it,
+// each in sorted order. A non-positive limit means no limit.
+func (index *metricIndex) search(query string, limit int) []api.MetricKey {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+	result := []api.MetricKey{}
+	full := func() bool {
+		return limit > 0 && len(result) >= limit
+	}
+	// The names with the query as a prefix form a contiguous range.
+	start := sort.SearchStrings(index.names, query)
+	end := start
+	for end < len(index.names) && strings.HasPrefix(index.names[end], query) {
+		if full() {
+			return result
+		}
+		result = append(result, api.MetricKey(index.names[end]))
+		end++
+	}
+	for i, name := range index.names {
+		if full() {
+			break
+		}
+		if start <= i && i < end {
+			continue
+		}
+		if strings.Contains(name, query) {
+			result = append(result, api.MetricKey(name))
+		}
+	}
+	return result
+}
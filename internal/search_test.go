@@ -0,0 +1,53 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func Test_metricIndex(t *testing.T) {
+	a := assert.New(t)
+	index := newMetricIndex()
+	now := time.Unix(1000, 0)
+	a.EqBool(index.stale(now), true)
+	index.replace([]api.MetricKey{"net.bytes", "cpu.user", "host.cpu.idle", "cpu.idle", "memory"}, now)
+	a.EqBool(index.stale(now.Add(time.Second)), false)
+	a.EqBool(index.stale(now.Add(searchIndexRefresh)), true)
+
+	for _, test := range []struct {
+		query    string
+		limit    int
+		expected []api.MetricKey
+	}{
+		{"cpu", 0, []api.MetricKey{"cpu.idle", "cpu.user", "host.cpu.idle"}},
+		{"cpu", 2, []api.MetricKey{"cpu.idle", "cpu.user"}},
+		{"cpu", 1, []api.MetricKey{"cpu.idle"}},
+		{"idle", 0, []api.MetricKey{"cpu.idle", "host.cpu.idle"}},
+		{"cpu.u", 0, []api.MetricKey{"cpu.user"}},
+		{"disk", 0, []api.MetricKey{}},
+		{"", 2, []api.MetricKey{"cpu.idle", "cpu.user"}},
+	} {
+		a.Contextf("query=%s limit=%d", test.query, test.limit).Eq(index.search(test.query, test.limit), test.expected)
+	}
+
+	index.add("cpu.system")
+	index.add("cpu.user")
+	a.Eq(index.search("cpu.", 0), []api.MetricKey{"cpu.idle", "cpu.system", "cpu.user", "host.cpu.idle"})
+}
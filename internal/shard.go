@@ -0,0 +1,148 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"hash/fnv"
+	"sync"
+
+	"github.com/gocql/gocql"
+	"github.com/square/metrics/api"
+)
+
+// metricNameShards is the number of partitions of metric_name_set.
+// Changing it requires rewriting the table, since keys are found by their hash.
+const metricNameShards = 32
+
+// metricNameShard is the partition of metric_name_set holding the metric key.
+func metricNameShard(metricKey api.MetricKey) int {
+	hash := fnv.New32a()
+	hash.Write([]byte(metricKey))
+	return int(hash.Sum32() % metricNameShards)
+}
+
+// readMetricNameShards reads every partition of metric_name_set in parallel.
+// Keys written to shard 0 before the table was sharded are also found, since shard 0 is read too.
+func (db *defaultDatabase) readMetricNameShards() ([]api.MetricKey, error) {
+	shards := make([][]api.MetricKey, metricNameShards)
+	errors := make([]error, metricNameShards)
+	waiter := sync.WaitGroup{}
+	for shard := 0; shard < metricNameShards; shard++ {
+		waiter.Add(1)
+		go func(shard int) {
+			defer waiter.Done()
+			shards[shard], errors[shard] = db.readMetricNameShard(shard)
+		}(shard)
+	}
+	waiter.Wait()
+	seen := map[api.MetricKey]bool{}
+	keys := []api.MetricKey{}
+	for shard := range shards {
+		if errors[shard] != nil {
+			return nil, errors[shard]
+		}
+		for _, key := range shards[shard] {
+			// Keys may be in both their own shard and shard 0 until the migration is run.
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func (db *defaultDatabase) readMetricNameShard(shard int) ([]api.MetricKey, error) {
+	var keys []api.MetricKey
+	err := db.session.Query("SELECT metric_names FROM metric_name_set WHERE shard = ?", shard).Scan(&keys)
+	if err == gocql.ErrNotFound {
+		return nil, nil
+	}
+	return keys, err
+}
+
+// removeMetricKey removes the metric key from its shard of metric_name_set, and from shard 0,
+// where it is still found if it was written before the table was sharded and not migrated yet.
+func (db *defaultDatabase) removeMetricKey(metricKey api.MetricKey) error {
+	shard := metricNameShard(metricKey)
+	if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names - ? WHERE shard = ?", []string{string(metricKey)}, shard).Exec(); err != nil {
+		return err
+	}
+	if shard == 0 {
+		return nil
+	}
+	return db.session.Query("UPDATE metric_name_set SET metric_names = metric_names - ? WHERE shard = ?", []string{string(metricKey)}, 0).Exec()
+}
+
+// hasTagSets checks whether the metric has at least one tagset in metric_names.
+func (db *defaultDatabase) hasTagSets(metricKey api.MetricKey) (bool, error) {
+	var rawTag string
+	err := db.session.Query("SELECT tag_set FROM metric_names WHERE metric_key = ? LIMIT 1", metricKey).Scan(&rawTag)
+	if err == gocql.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// MigrateMetricNameSet moves the metric keys stored in shard 0 of metric_name_set,
+// as done before the table was sharded, into their own shards. It returns the number of keys moved.
+// Keys whose tagsets have all been removed are dropped instead of being moved.
+// It is safe to run while metrics are being added, and to run more than once. The janitor should not
+// run at the same time, since a key it removes between being checked and moved would be written back.
+func MigrateMetricNameSet(config api.Config) (int, error) {
+	database, err := NewCassandraDatabase(newClusterConfig(config))
+	if err != nil {
+		return 0, err
+	}
+	db := database.(*defaultDatabase)
+	defer db.session.Close()
+	return db.migrateMetricNameSet()
+}
+
+func (db *defaultDatabase) migrateMetricNameSet() (int, error) {
+	keys, err := db.readMetricNameShard(0)
+	if err != nil {
+		return 0, err
+	}
+	moved := 0
+	for _, key := range keys {
+		shard := metricNameShard(key)
+		if shard == 0 {
+			continue
+		}
+		exists, err := db.hasTagSets(key)
+		if err != nil {
+			return moved, err
+		}
+		if !exists {
+			if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names - ? WHERE shard = ?", []string{string(key)}, 0).Exec(); err != nil {
+				return moved, err
+			}
+			continue
+		}
+		// The key is added to its shard before it's removed from shard 0, so it can always be found.
+		if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names + ? WHERE shard = ?", []string{string(key)}, shard).Exec(); err != nil {
+			return moved, err
+		}
+		if err := db.session.Query("UPDATE metric_name_set SET metric_names = metric_names - ? WHERE shard = ?", []string{string(key)}, 0).Exec(); err != nil {
+			return moved, err
+		}
+		moved++
+	}
+	return moved, nil
+}
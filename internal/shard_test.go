@@ -0,0 +1,43 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func Test_metricNameShard(t *testing.T) {
+	a := assert.New(t)
+	counts := make([]int, metricNameShards)
+	for i := 0; i < 100*metricNameShards; i++ {
+		key := api.MetricKey(fmt.Sprintf("metric.%d", i))
+		shard := metricNameShard(key)
+		if shard < 0 || shard >= metricNameShards {
+			t.Fatalf("Shard %d of %s is out of range", shard, key)
+		}
+		a.EqInt(metricNameShard(key), shard)
+		counts[shard]++
+	}
+	// The keys should be spread over every shard.
+	for shard, count := range counts {
+		if count == 0 {
+			t.Errorf("No keys were assigned to shard %d", shard)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/square/metrics/api"
+)
+
+// RawTransform changes the value of a tag extracted from the graphite metric.
+// The steps are applied in the order of the fields.
+// Only mappings can be reversed, so the other steps require the rule to be one-way.
+type RawTransform struct {
+	Lowercase bool              `yaml:"lowercase,omitempty"`
+	Replace   string            `yaml:"replace,omitempty"` // regex whose matches are replaced by With.
+	With      string            `yaml:"with,omitempty"`
+	Map       map[string]string `yaml:"map,omitempty"`
+}
+
+// transform is a compiled RawTransform.
+type transform struct {
+	lowercase bool
+	replace   *regexp.Regexp
+	with      string
+	mapping   map[string]string
+	inverse   map[string]string // of the mapping; only set if the rule is reversible.
+}
+
+// compileTransforms compiles the transforms of the rule, checking that they can be reversed unless the rule is one-way.
+func (rule RawRule) compileTransforms(graphitePatternTags []string) (map[string]transform, error) {
+	for tagKey := range rule.Tags {
+		if isSubset([]string{tagKey}, graphitePatternTags) {
+			return nil, newInvalidStaticTag(rule.MetricKeyPattern, tagKey)
+		}
+	}
+	transforms := make(map[string]transform)
+	for tag, raw := range rule.Transforms {
+		if !isSubset([]string{tag}, graphitePatternTags) {
+			return nil, newInvalidTransform(rule.MetricKeyPattern, tag)
+		}
+		compiled := transform{
+			lowercase: raw.Lowercase,
+			with:      raw.With,
+			mapping:   raw.Map,
+		}
+		if raw.Replace != "" {
+			regex, err := regexp.Compile(raw.Replace)
+			if err != nil {
+				return nil, newInvalidTransform(rule.MetricKeyPattern, tag)
+			}
+			compiled.replace = regex
+		}
+		if !rule.OneWay {
+			if compiled.lowercase || compiled.replace != nil {
+				return nil, newInvalidTransform(rule.MetricKeyPattern, tag)
+			}
+			compiled.inverse = make(map[string]string)
+			for from, to := range raw.Map {
+				if _, duplicate := compiled.inverse[to]; duplicate {
+					return nil, newInvalidTransform(rule.MetricKeyPattern, tag)
+				}
+				compiled.inverse[to] = from
+			}
+		}
+		transforms[tag] = compiled
+	}
+	return transforms, nil
+}
+
+// apply transforms the extracted value. It fails if the result couldn't be reversed.
+func (t transform) apply(value string) (string, bool) {
+	if t.lowercase {
+		value = strings.ToLower(value)
+	}
+	if t.replace != nil {
+		value = t.replace.ReplaceAllString(value, t.with)
+	}
+	if mapped, ok := t.mapping[value]; ok {
+		return mapped, true
+	}
+	if _, ok := t.inverse[value]; ok {
+		// the value would be mistaken for the result of the mapping when reversed.
+		return "", false
+	}
+	return value, true
+}
+
+// reverse finds the extracted value which was transformed to the given value.
+func (t transform) reverse(value string) (string, bool) {
+	if original, ok := t.inverse[value]; ok {
+		return original, true
+	}
+	if _, ok := t.mapping[value]; ok {
+		// mapped values are never left as they are.
+		return "", false
+	}
+	return value, true
+}
+
+// applyTransforms transforms the values of the extracted tags in place.
+func applyTransforms(transforms map[string]transform, tagSet api.TagSet) bool {
+	for tag, t := range transforms {
+		value, ok := t.apply(tagSet[tag])
+		if !ok {
+			return false
+		}
+		tagSet[tag] = value
+	}
+	return true
+}
+
+// reverseTransforms restores the values of the extracted tags in place.
+func reverseTransforms(transforms map[string]transform, tagSet api.TagSet) bool {
+	for tag, t := range transforms {
+		value, present := tagSet[tag]
+		if !present {
+			continue // reported as a missing tag when interpolated.
+		}
+		original, ok := t.reverse(value)
+		if !ok {
+			return false
+		}
+		tagSet[tag] = original
+	}
+	return true
+}
+
+// metricKeyRule returns a copy of the rule whose custom regexes also match the results of the mappings,
+// so that they can be extracted from the metric key.
+func (rule RawRule) metricKeyRule() RawRule {
+	regexes := make(map[string]string)
+	for tag, regex := range rule.Regex {
+		regexes[tag] = regex
+	}
+	for tag, raw := range rule.Transforms {
+		if len(raw.Map) == 0 {
+			continue
+		}
+		alternatives := []string{}
+		for _, to := range raw.Map {
+			alternatives = append(alternatives, regexp.QuoteMeta(to))
+		}
+		sort.Strings(alternatives)
+		regex, contains := rule.Regex[tag]
+		if !contains {
+			regex = defaultRegex
+		}
+		regexes[tag] = strings.Join(append(alternatives, regex), "|")
+	}
+	rule.Regex = regexes
+	return rule
+}
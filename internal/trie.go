@@ -0,0 +1,77 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"sort"
+	"strings"
+)
+
+// ruleTrie indexes the rules by the literal dot-separated segments at the start of their patterns,
+// so that only the rules which can match a metric are tried.
+type ruleTrie struct {
+	children map[string]*ruleTrie
+	rules    []int // indices of the rules whose literal prefix ends here, in order.
+}
+
+func newRuleTrie(rules []Rule) *ruleTrie {
+	root := &ruleTrie{children: make(map[string]*ruleTrie)}
+	for index, rule := range rules {
+		node := root
+		for _, segment := range literalPrefix(rule.raw.Pattern) {
+			child, ok := node.children[segment]
+			if !ok {
+				child = &ruleTrie{children: make(map[string]*ruleTrie)}
+				node.children[segment] = child
+			}
+			node = child
+		}
+		node.rules = append(node.rules, index)
+	}
+	return root
+}
+
+// literalPrefix returns the segments of the pattern before the first one holding a tag.
+// The last segment is never included, since a metric may end where it does.
+func literalPrefix(pattern string) []string {
+	segments := strings.Split(pattern, ".")
+	for i, segment := range segments[:len(segments)-1] {
+		if strings.Contains(segment, "%") {
+			return segments[:i]
+		}
+	}
+	return segments[:len(segments)-1]
+}
+
+// candidates returns the indices of the rules which may match the input, in order.
+func (trie *ruleTrie) candidates(input string) []int {
+	result := append([]int{}, trie.rules...)
+	node := trie
+	for {
+		dot := strings.IndexByte(input, '.')
+		if dot < 0 {
+			break
+		}
+		child, ok := node.children[input[:dot]]
+		if !ok {
+			break
+		}
+		result = append(result, child.rules...)
+		node = child
+		input = input[dot+1:]
+	}
+	sort.Ints(result)
+	return result
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/square/metrics/assert"
+)
+
+func Test_literalPrefix(t *testing.T) {
+	for _, test := range []struct {
+		pattern  string
+		expected []string
+	}{
+		{"%app%.latency", []string{}},
+		{"foo.%app%", []string{"foo"}},
+		{"foo.bar.%app%.baz", []string{"foo", "bar"}},
+		{"foo.bar%app%.baz", []string{"foo"}},
+		{"foo.bar.baz", []string{"foo", "bar"}},
+		{"foo", []string{}},
+	} {
+		a := assert.New(t).Contextf("%s", test.pattern)
+		a.Eq(literalPrefix(test.pattern), test.expected)
+	}
+}
+
+func TestRuleSet_MatchRuleTrie(t *testing.T) {
+	a := assert.New(t)
+	ruleSet := compileRuleSet(a,
+		RawRule{Pattern: "foo.bar.%app%", MetricKeyPattern: "first"},
+		RawRule{Pattern: "%service%.bar.%app%", MetricKeyPattern: "second-%service%"},
+		RawRule{Pattern: "foo.%name%.%app%", MetricKeyPattern: "third-%name%"},
+		RawRule{Pattern: "foo.bar.baz", MetricKeyPattern: "fourth"},
+		RawRule{Pattern: "foo.%path%", MetricKeyPattern: "fifth", Regex: map[string]string{"path": ".+"}},
+	)
+	for _, input := range []string{
+		"foo.bar.server",
+		"foo.bar.baz",
+		"qux.bar.server",
+		"foo.qux.server",
+		"foo.qux.server.latency",
+		"foo",
+		"foo.",
+		"bar.qux.server",
+	} {
+		a := a.Contextf("%s", input)
+		expected, expectedIndex, expectedMatched := ruleSet.matchRuleLinear(input)
+		actual, index, matched := ruleSet.MatchRuleIndex(input)
+		a.EqBool(matched, expectedMatched)
+		a.EqInt(index, expectedIndex)
+		a.Eq(actual, expected)
+	}
+}
+
+// benchmarkRuleSet has a rule for each of many services, like large production rule sets.
+func benchmarkRuleSet(b *testing.B) (RuleSet, []string) {
+	rules := []Rule{}
+	inputs := []string{}
+	for i := 0; i < 500; i++ {
+		rule, err := Compile(RawRule{
+			Pattern:          fmt.Sprintf("service%d.%%host%%.%%name%%", i),
+			MetricKeyPattern: fmt.Sprintf("service%d.%%name%%", i),
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+		rules = append(rules, rule)
+		inputs = append(inputs, fmt.Sprintf("service%d.host%d.latency", i, i%10))
+	}
+	return newRuleSet(rules), inputs
+}
+
+func BenchmarkMatchRule_Trie(b *testing.B) {
+	ruleSet, inputs := benchmarkRuleSet(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ruleSet.MatchRule(inputs[i%len(inputs)])
+	}
+}
+
+func BenchmarkMatchRule_Linear(b *testing.B) {
+	ruleSet, inputs := benchmarkRuleSet(b)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ruleSet.matchRuleLinear(inputs[i%len(inputs)])
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"io/ioutil"
 	standard_log "log"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/square/metrics/api"
 	"github.com/square/metrics/api/backend/blueflood"
@@ -61,7 +63,8 @@ func LoadConfig() Config {
 		ExitWithMessage(fmt.Sprintf("unable to load config file `%s`", *ConfigFile))
 	}
 
-	fmt.Printf("parsed config: %#v\n", config)
+	// Diagnostics go to stderr, so that the output of programs can be piped.
+	fmt.Fprintf(os.Stderr, "parsed config: %#v\n", config)
 
 	return config
 }
@@ -87,6 +90,23 @@ func NewAPI(config api.Config) api.API {
 	return apiInstance
 }
 
+// ReloadRulesOnSignal reloads the conversion rules of the API whenever the process receives SIGHUP.
+// If the new rules fail to compile, the API keeps its current rules.
+func ReloadRulesOnSignal(apiInstance api.API) {
+	reloadable, ok := apiInstance.(internal.ReloadableAPI)
+	if !ok {
+		return
+	}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	go func() {
+		for range signals {
+			// Errors are logged by ReloadRules.
+			reloadable.ReloadRules()
+		}
+	}()
+}
+
 func SetupLogger() {
 	if *Logger == "glog" {
 		log.InitLogger(&glog.Logger{})
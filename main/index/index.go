@@ -0,0 +1,306 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// program which exports the metric index to a file of JSON lines,
+// imports such a file into the index, or compares two indexes.
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/main/common"
+)
+
+var (
+	mode       = flag.String("mode", "", "One of export, import or diff.")
+	file       = flag.String("file", "", "File of JSON lines to export to (stdout if empty), import from, or compare.")
+	other      = flag.String("other", "", "In diff mode, the file to compare with. If empty, the file is compared with the index.")
+	checkpoint = flag.String("checkpoint", "", "In import mode, file recording the number of imported lines, to resume from.")
+	batchSize  = flag.Int("batch-size", 1000, "In import mode, the number of metrics added at once.")
+)
+
+// entry is a line of the export file.
+type entry struct {
+	MetricKey api.MetricKey `json:"metric_key"`
+	TagSet    api.TagSet    `json:"tags"`
+}
+
+// id identifies the series of the entry.
+func (e entry) id() string {
+	return string(e.MetricKey) + " " + e.TagSet.Serialize()
+}
+
+func main() {
+	flag.Parse()
+	common.SetupLogger()
+
+	switch *mode {
+	case "export":
+		exportIndex()
+	case "import":
+		importIndex()
+	case "diff":
+		diffIndexes()
+	default:
+		common.ExitWithMessage("mode must be one of export, import or diff.\n")
+	}
+}
+
+func exportIndex() {
+	apiInstance := common.NewAPI(common.LoadConfig().API)
+	output := os.Stdout
+	if *file != "" {
+		created, err := os.Create(*file)
+		if err != nil {
+			common.ExitWithMessage(fmt.Sprintf("Cannot create %s: %s\n", *file, err.Error()))
+		}
+		defer created.Close()
+		output = created
+	}
+	writer := bufio.NewWriter(output)
+	count := 0
+	err := readIndex(apiInstance, func(e entry) error {
+		count++
+		return writeEntry(writer, e)
+	})
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Export failed after %d series: %s\n", count, err.Error()))
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d series\n", count)
+}
+
+// readIndex calls the callback for every series of the index, ordered by metric key.
+func readIndex(apiInstance api.API, callback func(entry) error) error {
+	keys, err := apiInstance.GetAllMetrics()
+	if err != nil {
+		return err
+	}
+	sort.Sort(api.MetricKeys(keys))
+	for _, key := range keys {
+		tagSets, err := apiInstance.GetAllTags(key, time.Time{})
+		if err != nil {
+			return err
+		}
+		for _, tagSet := range tagSets {
+			if err := callback(entry{key, tagSet}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func writeEntry(writer io.Writer, e entry) error {
+	encoded, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(writer, "%s\n", encoded)
+	return err
+}
+
+func importIndex() {
+	if *file == "" {
+		common.ExitWithRequired("file")
+	}
+	if *batchSize <= 0 {
+		common.ExitWithMessage("batch-size must be positive.\n")
+	}
+	apiInstance := common.NewAPI(common.LoadConfig().API)
+	input, err := os.Open(*file)
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot open %s: %s\n", *file, err.Error()))
+	}
+	defer input.Close()
+	done := readCheckpoint()
+	if done > 0 {
+		fmt.Printf("Resuming after line %d\n", done)
+	}
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	imported := 0
+	failed := 0
+	// checkpointed stops advancing at the line before the first failed series, so that it is retried when resuming.
+	checkpointed := done
+	stalled := false
+	batch := []api.TaggedMetric{}
+	batchLines := []int{}
+	flush := func() {
+		for i, err := range apiInstance.AddMetrics(batch) {
+			if err != nil {
+				fmt.Printf("Cannot add %s %s: %s\n", batch[i].MetricKey, batch[i].TagSet.Serialize(), err.Error())
+				failed++
+				if !stalled {
+					stalled = true
+					checkpointed = batchLines[i] - 1
+				}
+			} else {
+				imported++
+			}
+		}
+		if !stalled {
+			checkpointed = line
+		}
+		batch = []api.TaggedMetric{}
+		batchLines = []int{}
+		writeCheckpoint(checkpointed)
+	}
+	for scanner.Scan() {
+		line++
+		if line <= done || strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil || e.MetricKey == "" {
+			// The lines before are imported, so the import can be resumed once the file is fixed.
+			line--
+			flush()
+			common.ExitWithMessage(fmt.Sprintf("Invalid entry on line %d of %s\n", line+1, *file))
+		}
+		if e.TagSet == nil {
+			e.TagSet = api.NewTagSet()
+		}
+		batch = append(batch, api.TaggedMetric{MetricKey: e.MetricKey, TagSet: e.TagSet})
+		batchLines = append(batchLines, line)
+		if len(batch) >= *batchSize {
+			flush()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot read %s: %s\n", *file, err.Error()))
+	}
+	flush()
+	fmt.Printf("Imported %d series, %d failed\n", imported, failed)
+	if failed > 0 {
+		common.ExitWithMessage(fmt.Sprintf("Failed to import %d series\n", failed))
+	}
+}
+
+// readCheckpoint returns the number of lines already imported.
+func readCheckpoint() int {
+	if *checkpoint == "" {
+		return 0
+	}
+	content, err := ioutil.ReadFile(*checkpoint)
+	if os.IsNotExist(err) {
+		return 0
+	}
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot read the checkpoint %s: %s\n", *checkpoint, err.Error()))
+	}
+	done, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Invalid checkpoint %s\n", *checkpoint))
+	}
+	return done
+}
+
+// writeCheckpoint records the number of lines imported, replacing the checkpoint at once.
+func writeCheckpoint(done int) {
+	if *checkpoint == "" {
+		return
+	}
+	temporary := *checkpoint + ".tmp"
+	if err := ioutil.WriteFile(temporary, []byte(strconv.Itoa(done)+"\n"), 0644); err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot write the checkpoint %s: %s\n", temporary, err.Error()))
+	}
+	if err := os.Rename(temporary, *checkpoint); err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot write the checkpoint %s: %s\n", *checkpoint, err.Error()))
+	}
+}
+
+func diffIndexes() {
+	if *file == "" {
+		common.ExitWithRequired("file")
+	}
+	before, err := readEntries(*file)
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot read %s: %s\n", *file, err.Error()))
+	}
+	after := map[string]entry{}
+	if *other != "" {
+		after, err = readEntries(*other)
+		if err != nil {
+			common.ExitWithMessage(fmt.Sprintf("Cannot read %s: %s\n", *other, err.Error()))
+		}
+	} else {
+		apiInstance := common.NewAPI(common.LoadConfig().API)
+		err = readIndex(apiInstance, func(e entry) error {
+			after[e.id()] = e
+			return nil
+		})
+		if err != nil {
+			common.ExitWithMessage(fmt.Sprintf("Cannot read the index: %s\n", err.Error()))
+		}
+	}
+	added := difference(after, before)
+	removed := difference(before, after)
+	for _, id := range added {
+		fmt.Printf("+ %s\n", id)
+	}
+	for _, id := range removed {
+		fmt.Printf("- %s\n", id)
+	}
+	fmt.Printf("%d added, %d removed\n", len(added), len(removed))
+}
+
+// readEntries reads an export file, by the id of each series.
+func readEntries(path string) (map[string]entry, error) {
+	input, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer input.Close()
+	entries := map[string]entry{}
+	scanner := bufio.NewScanner(input)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+		var e entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("invalid entry on line %d: %s", line, err.Error())
+		}
+		entries[e.id()] = e
+	}
+	return entries, scanner.Err()
+}
+
+// difference lists the ids of the series in left which are not in right, in order.
+func difference(left, right map[string]entry) []string {
+	result := []string{}
+	for id := range left {
+		if _, ok := right[id]; !ok {
+			result = append(result, id)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
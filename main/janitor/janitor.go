@@ -0,0 +1,79 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// program which removes the metrics which have not been seen
+// for longer than the given TTL from the index.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/internal"
+	"github.com/square/metrics/main/common"
+)
+
+var (
+	ttl    = flag.Duration("ttl", 30*24*time.Hour, "Metrics not seen for this long are removed.")
+	dryRun = flag.Bool("dry-run", false, "If true, list the stale metrics without removing them.")
+)
+
+func main() {
+	flag.Parse()
+	common.SetupLogger()
+
+	config := common.LoadConfig()
+
+	apiInstance, ok := common.NewAPI(config.API).(internal.ExpiringAPI)
+	if !ok {
+		common.ExitWithMessage("The API does not record when metrics were last seen.")
+	}
+	if *ttl < internal.MinStaleAge {
+		common.ExitWithMessage(fmt.Sprintf("ttl must be at least %s.", internal.MinStaleAge))
+	}
+	found := 0
+	removed := 0
+	failed := 0
+	err := apiInstance.ScanStaleMetrics(time.Now().Add(-*ttl), func(stale []api.TaggedMetric) error {
+		found += len(stale)
+		for _, metric := range stale {
+			fmt.Printf("%s %s\n", metric.MetricKey, metric.TagSet.Serialize())
+		}
+		if *dryRun {
+			return nil
+		}
+		for i, err := range apiInstance.RemoveMetrics(stale) {
+			if err != nil {
+				fmt.Printf("Cannot remove %s %s: %s\n", stale[i].MetricKey, stale[i].TagSet.Serialize(), err.Error())
+				failed++
+				continue
+			}
+			removed++
+		}
+		return nil
+	})
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Cannot list stale metrics after %d: %s", found, err.Error()))
+	}
+	if *dryRun {
+		fmt.Printf("Found %d stale metrics\n", found)
+		return
+	}
+	fmt.Printf("Found %d stale metrics, removed %d\n", found, removed)
+	if failed > 0 {
+		common.ExitWithMessage(fmt.Sprintf("Failed to remove %d stale metrics\n", failed))
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// program which moves the metric keys written to the single partition
+// of metric_name_set by earlier versions into their own shards, fills
+// tag_key_set from the tag index, and fills tag_set_index from metric_names.
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/square/metrics/internal"
+	"github.com/square/metrics/main/common"
+)
+
+func main() {
+	flag.Parse()
+	common.SetupLogger()
+
+	config := common.LoadConfig()
+
+	moved, err := internal.MigrateMetricNameSet(config.API)
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Migration failed after moving %d keys: %s", moved, err.Error()))
+	}
+	fmt.Printf("Moved %d keys\n", moved)
+
+	tagKeys, err := internal.BackfillTagKeySet(config.API)
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Backfill of tag keys failed after %d keys: %s", tagKeys, err.Error()))
+	}
+	fmt.Printf("Backfilled %d tag keys\n", tagKeys)
+
+	tagSets, err := internal.BackfillTagSetIndex(config.API)
+	if err != nil {
+		common.ExitWithMessage(fmt.Sprintf("Backfill of the tag set index failed after %d tagsets: %s", tagSets, err.Error()))
+	}
+	fmt.Printf("Backfilled %d tagsets\n", tagSets)
+}
@@ -33,6 +33,7 @@ func main() {
 	config := common.LoadConfig()
 
 	apiInstance := common.NewAPI(config.API)
+	common.ReloadRulesOnSignal(apiInstance)
 	myBackend := blueflood.NewBlueflood(config.Blueflood)
 
 	l := liner.NewLiner()
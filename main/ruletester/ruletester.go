@@ -20,9 +20,10 @@ package main
 
 import (
 	"bufio"
+	"encoding/json"
 	"flag"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os"
 	"sort"
 
@@ -32,23 +33,18 @@ import (
 )
 
 var (
+	format           = flag.String("format", "text", "Format of the report, either text or json.")
 	metricsFile      = flag.String("metrics-file", "", "Location of YAML configuration file.")
 	unmatchedFile    = flag.String("unmatched-file", "", "location of metrics list to output unmatched transformations.")
 	insertToDatabase = flag.Bool("insert-to-db", false, "If true, insert rows to database.")
+	lint             = flag.Bool("lint", false, "If true, check the rules for shadowed, overlapping and irreversible rules, without reading metrics.")
+	checkExamples    = flag.Bool("check-examples", false, "If true, check the examples of each rule, without reading metrics.")
 )
 
-func readRule(filename string) *internal.RuleSet {
-	file, err := os.Open(filename)
+func readRule(path string) *internal.RuleSet {
+	rule, err := internal.LoadRules(path)
 	if err != nil {
-		common.ExitWithMessage("No rule file")
-	}
-	bytes, err := ioutil.ReadAll(file)
-	if err != nil {
-		common.ExitWithMessage("Cannot read the rule YAML")
-	}
-	rule, err := internal.LoadYAML(bytes)
-	if err != nil {
-		common.ExitWithMessage("Cannot parse Rule file")
+		common.ExitWithMessage(fmt.Sprintf("Cannot load the rules: %s\n", err.Error()))
 	}
 	return &rule
 }
@@ -56,27 +52,60 @@ func readRule(filename string) *internal.RuleSet {
 // Statistics represents the aggregated result of rules
 // after running through the test file.
 type Statistics struct {
-	perMetric map[api.MetricKey]PerMetricStatistics
-	matched   int // number of matched rows
-	unmatched int // number of unmatched rows
+	Matched   int                            `json:"matched"`            // number of matched rows
+	Unmatched int                            `json:"unmatched"`          // number of unmatched rows
+	Rejected  int                            `json:"rejected,omitempty"` // number of rows rejected by the cardinality limits when inserted
+	Failed    int                            `json:"failed,omitempty"`   // number of rows which otherwise failed to be inserted
+	Rules     []PerRuleStatistics            `json:"rules"`              // in the order of the rules
+	DeadRules []int                          `json:"dead_rules"`         // indices of the rules which matched no rows
+	Caches    map[string]internal.CacheStats `json:"caches,omitempty"`   // write caches of the database, if inserted
 }
 
-// PerMetricStatistics represents per-metric result of rules
+// PerRuleStatistics represents per-rule result of rules
 // after running through the test file.
-type PerMetricStatistics struct {
-	matched          int // number of matched rows
-	reverseSuccess   int // number of reversed entries
-	reverseError     int // number of incorrectly reversed entries.
-	reverseIncorrect int // number of incorrectly reversed entries.
+type PerRuleStatistics struct {
+	Pattern          string             `json:"pattern"`
+	MetricKey        string             `json:"metric_key"`
+	Matched          int                `json:"matched"`           // number of matched rows
+	ReverseSuccess   int                `json:"reverse_success"`   // number of correctly reversed entries.
+	ReverseError     int                `json:"reverse_error"`     // number of entries which could not be reversed.
+	ReverseIncorrect int                `json:"reverse_incorrect"` // number of incorrectly reversed entries.
+	Examples         []string           `json:"examples"`          // first matched rows
+	Failures         []RoundTripFailure `json:"failures"`          // first rows which were not reversed correctly
 }
 
+// RoundTripFailure is a row which was not converted back to itself.
+type RoundTripFailure struct {
+	Expected string `json:"expected"`
+	Actual   string `json:"actual,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+const (
+	// maxExamples is the number of matched rows kept for each rule.
+	maxExamples = 3
+	// maxFailures is the number of round-trip failures kept for each rule.
+	maxFailures = 10
+)
+
 func main() {
 	flag.Parse()
 	common.SetupLogger()
 
 	config := common.LoadConfig()
 
+	if *format != "text" && *format != "json" {
+		common.ExitWithMessage(fmt.Sprintf("Unknown format '%s'", *format))
+	}
 	ruleset := readRule(config.API.ConversionRulesPath)
+	if *lint {
+		reportLint(ruleset.Lint())
+		return
+	}
+	if *checkExamples {
+		reportExamples(ruleset.CheckExamples())
+		return
+	}
 	metricFile, err := os.Open(*metricsFile)
 	if err != nil {
 		common.ExitWithMessage("No metric file.")
@@ -91,67 +120,179 @@ func main() {
 		}
 	}
 	stat := run(ruleset, scanner, apiInstance, output)
-	report(stat)
+	if *format == "json" {
+		if err := reportJSON(os.Stdout, stat); err != nil {
+			common.ExitWithMessage(fmt.Sprintf("Cannot write the report: %s", err.Error()))
+		}
+	} else {
+		report(stat)
+	}
+}
+
+// insertBatchSize is the number of metrics inserted to the database at once.
+const insertBatchSize = 1000
+
+// insert adds the metrics to the database, counting the ones which are rejected or fail.
+func insert(apiInstance api.API, metrics []api.TaggedMetric, stat *Statistics) {
+	for _, err := range apiInstance.AddMetrics(metrics) {
+		if err == nil {
+			continue
+		}
+		if _, ok := err.(internal.CardinalityError); ok {
+			stat.Rejected++
+		} else {
+			stat.Failed++
+		}
+	}
 }
 
 func run(ruleset *internal.RuleSet, scanner *bufio.Scanner, apiInstance api.API, unmatched *os.File) Statistics {
-	stat := Statistics{
-		perMetric: make(map[api.MetricKey]PerMetricStatistics),
+	stat := Statistics{Rules: []PerRuleStatistics{}}
+	for _, rule := range ruleset.Rules() {
+		stat.Rules = append(stat.Rules, PerRuleStatistics{
+			Pattern:   rule.Pattern,
+			MetricKey: rule.MetricKeyPattern,
+			Examples:  []string{},
+			Failures:  []RoundTripFailure{},
+		})
 	}
+	pending := []api.TaggedMetric{}
 	for scanner.Scan() {
 		input := scanner.Text()
-		converted, matched := ruleset.MatchRule(input)
+		converted, index, matched := ruleset.MatchRuleIndex(input)
 		if matched {
-			stat.matched++
-			perMetric := stat.perMetric[converted.MetricKey]
-			perMetric.matched++
+			stat.Matched++
+			perRule := &stat.Rules[index]
+			perRule.Matched++
+			if len(perRule.Examples) < maxExamples {
+				perRule.Examples = append(perRule.Examples, input)
+			}
 			reversed, err := ruleset.ToGraphiteName(converted)
 			if *insertToDatabase {
-				apiInstance.AddMetric(converted)
+				pending = append(pending, converted)
+				if len(pending) >= insertBatchSize {
+					insert(apiInstance, pending, &stat)
+					pending = []api.TaggedMetric{}
+				}
 			}
 			if err != nil {
-				perMetric.reverseError++
+				perRule.ReverseError++
+				perRule.addFailure(RoundTripFailure{Expected: input, Error: err.Error()})
 			} else if string(reversed) != input {
-				perMetric.reverseIncorrect++
+				perRule.ReverseIncorrect++
+				perRule.addFailure(RoundTripFailure{Expected: input, Actual: string(reversed)})
 			} else {
-				perMetric.reverseSuccess++
+				perRule.ReverseSuccess++
 			}
-			stat.perMetric[converted.MetricKey] = perMetric
 		} else {
-			stat.unmatched++
+			stat.Unmatched++
 			if unmatched != nil {
 				unmatched.WriteString(input)
 				unmatched.WriteString("\n")
 			}
 		}
 	}
+	if len(pending) > 0 {
+		insert(apiInstance, pending, &stat)
+	}
+	if cachingAPI, ok := apiInstance.(internal.CachingAPI); ok && *insertToDatabase {
+		stat.Caches = cachingAPI.CacheStats()
+	}
+	stat.DeadRules = []int{}
+	for index, perRule := range stat.Rules {
+		if perRule.Matched == 0 {
+			stat.DeadRules = append(stat.DeadRules, index)
+		}
+	}
 	return stat
 }
 
+func (perRule *PerRuleStatistics) addFailure(failure RoundTripFailure) {
+	if len(perRule.Failures) < maxFailures {
+		perRule.Failures = append(perRule.Failures, failure)
+	}
+}
+
+// reportLint prints the problems found in the rules, exiting with an error if there are any.
+func reportLint(warnings []internal.LintWarning) {
+	for _, warning := range warnings {
+		fmt.Println(warning.String())
+	}
+	if len(warnings) > 0 {
+		common.ExitWithMessage(fmt.Sprintf("Found %d problems in the rules\n", len(warnings)))
+	}
+	fmt.Println("No problems found in the rules")
+}
+
+// reportExamples prints the examples which aren't converted as expected, exiting with an error if there are any.
+func reportExamples(errors []internal.RuleError) {
+	for _, err := range errors {
+		fmt.Println(err.Error())
+	}
+	if len(errors) > 0 {
+		common.ExitWithMessage(fmt.Sprintf("Found %d invalid examples in the rules\n", len(errors)))
+	}
+	fmt.Println("All examples are converted as expected")
+}
+
 func report(stat Statistics) {
-	total := stat.matched + stat.unmatched
+	total := stat.Matched + stat.Unmatched
 	fmt.Printf("Processed %d entries\n", total)
-	fmt.Printf("Matched:   %d\n", stat.matched)
-	fmt.Printf("Unmatched: %d\n", stat.unmatched)
+	fmt.Printf("Matched:   %d\n", stat.Matched)
+	fmt.Printf("Unmatched: %d\n", stat.Unmatched)
+	if *insertToDatabase {
+		fmt.Printf("Rejected:  %d\n", stat.Rejected)
+		fmt.Printf("Failed:    %d\n", stat.Failed)
+	}
+	if len(stat.Caches) > 0 {
+		fmt.Printf("Cache statistics\n")
+		cacheNames := []string{}
+		for name := range stat.Caches {
+			cacheNames = append(cacheNames, name)
+		}
+		sort.Strings(cacheNames)
+		for _, name := range cacheNames {
+			cache := stat.Caches[name]
+			fmt.Printf("%-20s hits %d, misses %d, size %d\n", name, cache.Hits, cache.Misses, cache.Size)
+		}
+	}
 	fmt.Printf("Per-rule statistics\n")
-	rowformat := "%-60s %7d %7d %7d %7d\n"
-	headformat := "%-60s %7s %7s %7s %7s\n"
-	fmt.Printf(headformat, "name", "match", "rev-suc", "rev-err", "rev-fail")
-	sortedKeys := make([]string, len(stat.perMetric))
-	index := 0
-	for key := range stat.perMetric {
-		sortedKeys[index] = string(key)
-		index++
-	}
-	sort.Strings(sortedKeys)
-	for _, key := range sortedKeys {
-		perMetric := stat.perMetric[api.MetricKey(key)]
+	rowformat := "%4d %-60s %7d %7d %7d %7d\n"
+	headformat := "%4s %-60s %7s %7s %7s %7s\n"
+	fmt.Printf(headformat, "#", "pattern", "match", "rev-suc", "rev-err", "rev-fail")
+	for index, perRule := range stat.Rules {
 		fmt.Printf(rowformat,
-			string(key),
-			perMetric.matched,
-			perMetric.reverseSuccess,
-			perMetric.reverseError,
-			perMetric.reverseIncorrect,
+			index,
+			perRule.Pattern,
+			perRule.Matched,
+			perRule.ReverseSuccess,
+			perRule.ReverseError,
+			perRule.ReverseIncorrect,
 		)
 	}
+	if len(stat.DeadRules) > 0 {
+		fmt.Printf("Rules which matched nothing\n")
+		for _, index := range stat.DeadRules {
+			fmt.Printf("%4d %s -> %s\n", index, stat.Rules[index].Pattern, stat.Rules[index].MetricKey)
+		}
+	}
+	for index, perRule := range stat.Rules {
+		for _, failure := range perRule.Failures {
+			if failure.Error != "" {
+				fmt.Printf("Rule %d cannot reverse %s: %s\n", index, failure.Expected, failure.Error)
+			} else {
+				fmt.Printf("Rule %d reverses %s to %s\n", index, failure.Expected, failure.Actual)
+			}
+		}
+	}
+}
+
+// reportJSON writes the statistics as JSON.
+func reportJSON(writer io.Writer, stat Statistics) error {
+	encoded, err := json.MarshalIndent(stat, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(writer, string(encoded))
+	return err
 }
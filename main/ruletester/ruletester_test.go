@@ -0,0 +1,49 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/square/metrics/assert"
+	"github.com/square/metrics/internal"
+)
+
+func TestReportJSON(t *testing.T) {
+	a := assert.New(t)
+	ruleset, err := internal.LoadYAML([]byte(`
+rules:
+  -
+    pattern: foo.%app%.%name%
+    metric_key: foo.%name%
+  -
+    pattern: bar.%name%
+    metric_key: bar.%name%
+`))
+	a.CheckError(err)
+	scanner := bufio.NewScanner(strings.NewReader("foo.server.latency\nqux.unmatched\n"))
+	stat := run(&ruleset, scanner, nil, nil)
+	a.EqInt(stat.Matched+stat.Unmatched, 2)
+
+	buffer := bytes.Buffer{}
+	a.CheckError(reportJSON(&buffer, stat))
+	var decoded Statistics
+	a.CheckError(json.Unmarshal(buffer.Bytes(), &decoded))
+	a.Eq(decoded, stat)
+}
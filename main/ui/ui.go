@@ -33,6 +33,7 @@ func main() {
 	config := common.LoadConfig()
 
 	apiInstance := common.NewAPI(config.API)
+	common.ReloadRulesOnSignal(apiInstance)
 
 	blueflood := api.ProfilingBackend{
 		Backend: blueflood.NewBlueflood(config.Blueflood),
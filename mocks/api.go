@@ -18,6 +18,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/square/metrics/api"
 )
@@ -60,6 +63,14 @@ func (fa *FakeApi) RemoveMetric(metric api.TaggedMetric) error {
 	return nil
 }
 
+func (fa *FakeApi) AddMetrics(metrics []api.TaggedMetric) []error {
+	return make([]error, len(metrics))
+}
+
+func (fa *FakeApi) RemoveMetrics(metrics []api.TaggedMetric) []error {
+	return make([]error, len(metrics))
+}
+
 func (fa *FakeApi) ToGraphiteName(metric api.TaggedMetric) (api.GraphiteMetric, error) {
 	for k, v := range fa.metricMap {
 		if reflect.DeepEqual(v, metric) {
@@ -78,12 +89,26 @@ func (fa *FakeApi) ToTaggedName(metric api.GraphiteMetric) (api.TaggedMetric, er
 	return tm, nil
 }
 
-func (fa *FakeApi) GetAllTags(metricKey api.MetricKey) ([]api.TagSet, error) {
+func (fa *FakeApi) GetAllTags(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error) {
 	return fa.metricTagSets[metricKey], nil
 }
 
+func (fa *FakeApi) GetMatchingTags(metricKey api.MetricKey, predicate api.Predicate, activeSince time.Time) ([]api.TagSet, error) {
+	result := []api.TagSet{}
+	for _, tagSet := range fa.metricTagSets[metricKey] {
+		if predicate.Apply(tagSet) {
+			result = append(result, tagSet)
+		}
+	}
+	return result, nil
+}
+
 func (fa *FakeApi) GetAllMetrics() ([]api.MetricKey, error) {
-	return nil, errors.New("Implement me")
+	keys := []api.MetricKey{}
+	for key := range fa.metricTagSets {
+		keys = append(keys, key)
+	}
+	return keys, nil
 }
 
 // Adds a metric to the Key/Value set list.
@@ -96,6 +121,77 @@ func (fa *FakeApi) AddMetricsForTag(key string, value string, metric string) {
 	fa.metricsForTags[pair] = append(fa.metricsForTags[pair], api.MetricKey(metric))
 }
 
+// GetMetricsForTag returns the metrics added with AddMetricsForTag,
+// along with the metrics added with AddPair which have the given tag.
 func (fa *FakeApi) GetMetricsForTag(tagKey, tagValue string) ([]api.MetricKey, error) {
-	return nil, errors.New("Implement me")
+	pair := struct {
+		key   string
+		value string
+	}{tagKey, tagValue}
+	keys := []api.MetricKey{}
+	seen := map[api.MetricKey]bool{}
+	for _, key := range fa.metricsForTags[pair] {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	for key, tagSets := range fa.metricTagSets {
+		for _, tagSet := range tagSets {
+			if value, ok := tagSet[tagKey]; ok && value == tagValue && !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys, nil
+}
+
+func (fa *FakeApi) GetAllTagKeys() ([]string, error) {
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, tagSets := range fa.metricTagSets {
+		for _, tagSet := range tagSets {
+			for key := range tagSet {
+				if !seen[key] {
+					seen[key] = true
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
+func (fa *FakeApi) GetTagValues(tagKey string) ([]string, error) {
+	values := []string{}
+	seen := map[string]bool{}
+	for _, tagSets := range fa.metricTagSets {
+		for _, tagSet := range tagSets {
+			if value, ok := tagSet[tagKey]; ok && !seen[value] {
+				seen[value] = true
+				values = append(values, value)
+			}
+		}
+	}
+	return values, nil
+}
+
+func (fa *FakeApi) SearchMetrics(query string, limit int) ([]api.MetricKey, error) {
+	prefixed := []api.MetricKey{}
+	contained := []api.MetricKey{}
+	for key := range fa.metricTagSets {
+		if strings.HasPrefix(string(key), query) {
+			prefixed = append(prefixed, key)
+		} else if strings.Contains(string(key), query) {
+			contained = append(contained, key)
+		}
+	}
+	sort.Sort(api.MetricKeys(prefixed))
+	sort.Sort(api.MetricKeys(contained))
+	keys := append(prefixed, contained...)
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return keys, nil
 }
@@ -35,12 +35,18 @@ type ExecutionContext struct {
 	Registry   function.Registry // optional
 }
 
+// CommandResult is the result of executing a command.
+type CommandResult struct {
+	Body     interface{}            `json:"body"`               // JSON-encodable result of the command
+	Metadata map[string]interface{} `json:"metadata,omitempty"` // optional, JSON-encodable information about the result
+}
+
 // Command is the final result of the parsing.
 // A command contains all the information to execute the
 // given query against the API.
 type Command interface {
 	// Execute the given command. Returns JSON-encodable result or an error.
-	Execute(ExecutionContext) (interface{}, error)
+	Execute(ExecutionContext) (CommandResult, error)
 	Name() string
 }
 
@@ -54,10 +60,27 @@ type DescribeCommand struct {
 type DescribeAllCommand struct {
 }
 
-// DescribeMetricsCommand returns all metrics that use a particular key-value pair.
+// DescribeMetricsCommand returns all metrics with a tagset satisfying the predicate.
 type DescribeMetricsCommand struct {
-	tagKey   string
-	tagValue string
+	predicate api.Predicate
+}
+
+// DescribeTagsCommand returns the tag keys used by a metric, or by all metrics if no metric is given.
+type DescribeTagsCommand struct {
+	metricName api.MetricKey // empty for all metrics
+	predicate  api.Predicate
+}
+
+// DescribeValuesCommand returns the values taken by a tag key, optionally restricted to a single metric.
+type DescribeValuesCommand struct {
+	tagKey     string
+	metricName api.MetricKey // empty for all metrics
+	predicate  api.Predicate
+}
+
+// DescribeCardinalityCommand reports the number of tagsets and tag values of a metric, or of all metrics if no metric is given.
+type DescribeCardinalityCommand struct {
+	metricName api.MetricKey // empty for all metrics
 }
 
 // SelectCommand is the bread and butter of the metrics query engine.
@@ -66,51 +89,215 @@ type SelectCommand struct {
 	predicate   api.Predicate
 	expressions []function.Expression
 	context     *evaluationContextNode
+	pagination  *paginationNode
 }
 
 // Execute returns the list of tags satisfying the provided predicate.
-func (cmd *DescribeCommand) Execute(context ExecutionContext) (interface{}, error) {
-	tags, _ := context.API.GetAllTags(cmd.metricName)
+func (cmd *DescribeCommand) Execute(context ExecutionContext) (CommandResult, error) {
+	tags, _ := context.API.GetMatchingTags(cmd.metricName, cmd.predicate, time.Time{})
 	output := make([]string, 0, len(tags))
 	for _, tag := range tags {
-		if cmd.predicate.Apply(tag) {
-			output = append(output, tag.Serialize())
-		}
+		output = append(output, tag.Serialize())
 	}
 	sort.Strings(output)
-	return output, nil
+	return CommandResult{Body: output}, nil
 }
 func (cmd *DescribeCommand) Name() string {
 	return "describe"
 }
 
 // Execute of a DescribeAllCommand returns the list of all metrics.
-func (cmd *DescribeAllCommand) Execute(context ExecutionContext) (interface{}, error) {
+func (cmd *DescribeAllCommand) Execute(context ExecutionContext) (CommandResult, error) {
 	result, err := context.API.GetAllMetrics()
-	if err == nil {
-		sort.Sort(api.MetricKeys(result))
+	if err != nil {
+		return CommandResult{}, err
 	}
-	return result, err
+	sort.Sort(api.MetricKeys(result))
+	return CommandResult{Body: result}, nil
 }
 
 func (cmd *DescribeAllCommand) Name() string {
 	return "describe all"
 }
 
-// Execute asks for all metrics with the given name.
-func (cmd *DescribeMetricsCommand) Execute(context ExecutionContext) (interface{}, error) {
-	return context.API.GetMetricsForTag(cmd.tagKey, cmd.tagValue)
+// Execute asks for all metrics with a tagset satisfying the predicate.
+// The tag index is used to find candidate metrics where possible; otherwise, every metric is scanned.
+func (cmd *DescribeMetricsCommand) Execute(context ExecutionContext) (CommandResult, error) {
+	candidates, err := indexCandidates(cmd.predicate, context.API)
+	if err != nil {
+		return CommandResult{}, err
+	}
+	if candidates != nil && candidates.exact {
+		return CommandResult{Body: candidates.keys()}, nil
+	}
+	var keys []api.MetricKey
+	if candidates == nil {
+		// The index can't narrow down the metrics, so every metric is a candidate.
+		keys, err = context.API.GetAllMetrics()
+		if err != nil {
+			return CommandResult{}, err
+		}
+	} else {
+		keys = candidates.keys()
+	}
+	result := []api.MetricKey{}
+	for _, key := range keys {
+		tagSets, err := context.API.GetAllTags(key, time.Time{})
+		if err != nil {
+			return CommandResult{}, err
+		}
+		for _, tagSet := range tagSets {
+			if cmd.predicate.Apply(tagSet) {
+				result = append(result, key)
+				break
+			}
+		}
+	}
+	sort.Sort(api.MetricKeys(result))
+	return CommandResult{Body: result}, nil
 }
 
 func (cmd *DescribeMetricsCommand) Name() string {
 	return "describe metrics"
 }
 
+// Execute returns the sorted list of tag keys of the tagsets satisfying the predicate.
+func (cmd *DescribeTagsCommand) Execute(context ExecutionContext) (CommandResult, error) {
+	if cmd.metricName == "" {
+		keys, err := context.API.GetAllTagKeys()
+		if err != nil {
+			return CommandResult{}, err
+		}
+		sort.Strings(keys)
+		return CommandResult{Body: keys}, nil
+	}
+	tagSets, err := context.API.GetMatchingTags(cmd.metricName, cmd.predicate, time.Time{})
+	if err != nil {
+		return CommandResult{}, err
+	}
+	keys := map[string]bool{}
+	for _, tagSet := range tagSets {
+		for key := range tagSet {
+			keys[key] = true
+		}
+	}
+	return CommandResult{Body: sortedKeys(keys)}, nil
+}
+
+func (cmd *DescribeTagsCommand) Name() string {
+	return "describe tags"
+}
+
+// Execute returns the sorted list of values for the tag key.
+// If no metric is given, the predicate is applied to a tagset consisting only of the tag key and each of its values.
+func (cmd *DescribeValuesCommand) Execute(context ExecutionContext) (CommandResult, error) {
+	values := map[string]bool{}
+	if cmd.metricName == "" {
+		allValues, err := context.API.GetTagValues(cmd.tagKey)
+		if err != nil {
+			return CommandResult{}, err
+		}
+		for _, value := range allValues {
+			if cmd.predicate.Apply(api.TagSet{cmd.tagKey: value}) {
+				values[value] = true
+			}
+		}
+		return CommandResult{Body: sortedKeys(values)}, nil
+	}
+	tagSets, err := context.API.GetMatchingTags(cmd.metricName, cmd.predicate, time.Time{})
+	if err != nil {
+		return CommandResult{}, err
+	}
+	for _, tagSet := range tagSets {
+		value, ok := tagSet[cmd.tagKey]
+		if ok {
+			values[value] = true
+		}
+	}
+	return CommandResult{Body: sortedKeys(values)}, nil
+}
+
+func (cmd *DescribeValuesCommand) Name() string {
+	return "describe values"
+}
+
+// cardinality is the result of a DescribeCardinalityCommand.
+type cardinality struct {
+	TagSets map[api.MetricKey]int `json:"tagsets"` // number of tagsets of each metric
+	Values  map[string]int        `json:"values"`  // number of distinct values of each tag key
+}
+
+// Execute counts the tagsets of the metric and the distinct values of each of its tag keys.
+// If no metric is given, the tagsets of every metric and the values of every tag key are counted.
+func (cmd *DescribeCardinalityCommand) Execute(context ExecutionContext) (CommandResult, error) {
+	result := cardinality{
+		TagSets: map[api.MetricKey]int{},
+		Values:  map[string]int{},
+	}
+	if cmd.metricName != "" {
+		tagSets, err := context.API.GetAllTags(cmd.metricName, time.Time{})
+		if err != nil {
+			return CommandResult{}, err
+		}
+		result.TagSets[cmd.metricName] = len(tagSets)
+		values := map[string]map[string]bool{}
+		for _, tagSet := range tagSets {
+			for key, value := range tagSet {
+				if values[key] == nil {
+					values[key] = map[string]bool{}
+				}
+				values[key][value] = true
+			}
+		}
+		for key, set := range values {
+			result.Values[key] = len(set)
+		}
+		return CommandResult{Body: result}, nil
+	}
+	metrics, err := context.API.GetAllMetrics()
+	if err != nil {
+		return CommandResult{}, err
+	}
+	for _, metric := range metrics {
+		tagSets, err := context.API.GetAllTags(metric, time.Time{})
+		if err != nil {
+			return CommandResult{}, err
+		}
+		result.TagSets[metric] = len(tagSets)
+	}
+	keys, err := context.API.GetAllTagKeys()
+	if err != nil {
+		return CommandResult{}, err
+	}
+	for _, key := range keys {
+		values, err := context.API.GetTagValues(key)
+		if err != nil {
+			return CommandResult{}, err
+		}
+		result.Values[key] = len(values)
+	}
+	return CommandResult{Body: result}, nil
+}
+
+func (cmd *DescribeCardinalityCommand) Name() string {
+	return "describe cardinality"
+}
+
+// sortedKeys returns the keys of the given set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for key := range set {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // Execute performs the query represented by the given query string, and returs the result.
-func (cmd *SelectCommand) Execute(context ExecutionContext) (interface{}, error) {
+func (cmd *SelectCommand) Execute(context ExecutionContext) (CommandResult, error) {
 	timerange, err := api.NewSnappedTimerange(cmd.context.Start, cmd.context.End, cmd.context.Resolution)
 	if err != nil {
-		return nil, err
+		return CommandResult{}, err
 	}
 	hasTimeout := context.Timeout != 0
 	var cancellable api.Cancellable
@@ -136,9 +323,10 @@ func (cmd *SelectCommand) Execute(context ExecutionContext) (interface{}, error)
 		Profiler:     context.Profiler,
 		Registry:     r,
 	}
+	var values []function.Value
 	if hasTimeout {
 		timeout := time.After(context.Timeout)
-		results := make(chan interface{})
+		results := make(chan []function.Value)
 		errors := make(chan error)
 		go func() {
 			result, err := evaluateExpressions(evaluationContext, cmd.expressions)
@@ -150,15 +338,22 @@ func (cmd *SelectCommand) Execute(context ExecutionContext) (interface{}, error)
 		}()
 		select {
 		case <-timeout:
-			return nil, fmt.Errorf("Timeout while executing the query.") // timeout.
-		case result := <-results:
-			return result, nil
+			return CommandResult{}, fmt.Errorf("Timeout while executing the query.") // timeout.
+		case values = <-results:
 		case err := <-errors:
-			return nil, err
+			return CommandResult{}, err
 		}
 	} else {
-		return evaluateExpressions(evaluationContext, cmd.expressions)
+		values, err = evaluateExpressions(evaluationContext, cmd.expressions)
+		if err != nil {
+			return CommandResult{}, err
+		}
+	}
+	if cmd.pagination == nil || cmd.pagination.isEmpty() {
+		return CommandResult{Body: values}, nil
 	}
+	values, metadata := cmd.pagination.paginate(values)
+	return CommandResult{Body: values, Metadata: metadata}, nil
 }
 
 func (cmd *SelectCommand) Name() string {
@@ -183,7 +378,7 @@ func (cmd ProfilingCommand) Name() string {
 	return cmd.Command.Name()
 }
 
-func (cmd ProfilingCommand) Execute(context ExecutionContext) (interface{}, error) {
+func (cmd ProfilingCommand) Execute(context ExecutionContext) (CommandResult, error) {
 	defer cmd.Profiler.Record(fmt.Sprintf("%s.Execute", cmd.Name()))()
 	context.API = api.ProfilingAPI{
 		Profiler: cmd.Profiler,
@@ -17,6 +17,7 @@ package query
 
 import (
 	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -87,11 +88,130 @@ func TestCommand_Describe(t *testing.T) {
 		}
 		a.EqString(command.Name(), "describe")
 		rawResult, _ := command.Execute(ExecutionContext{Backend: nil, API: test.backend, FetchLimit: 1000, Timeout: 0})
-		parsedResult := rawResult.([]string)
+		parsedResult := rawResult.Body.([]string)
 		a.EqInt(len(parsedResult), test.length)
 	}
 }
 
+func TestCommand_DescribeMetrics(t *testing.T) {
+	fakeApi := mocks.NewFakeApi()
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=west,env=production")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=east,env=staging")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_1", api.ParseTagSet("dc=west,env=staging")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_2", api.ParseTagSet("dc=north")}, emptyGraphiteName)
+
+	for _, test := range []struct {
+		query    string
+		expected []api.MetricKey
+	}{
+		{"describe metrics where dc = 'west'", []api.MetricKey{"series_0", "series_1"}},
+		{"describe metrics where dc = 'nowhere'", []api.MetricKey{}},
+		{"describe metrics where dc in ('east', 'north')", []api.MetricKey{"series_0", "series_2"}},
+		{"describe metrics where dc = 'north' or env = 'production'", []api.MetricKey{"series_0", "series_2"}},
+		// series_0 has both tags, but not in the same tagset.
+		{"describe metrics where dc = 'east' and env = 'production'", []api.MetricKey{}},
+		{"describe metrics where dc = 'west' and env = 'staging'", []api.MetricKey{"series_1"}},
+		{"describe metrics where dc = 'west' and not env = 'staging'", []api.MetricKey{"series_0"}},
+		{"describe metrics where not dc = 'west'", []api.MetricKey{"series_0", "series_2"}},
+		{"describe metrics where dc matches '^(no|ea)'", []api.MetricKey{"series_0", "series_2"}},
+		{"describe metrics where dc matches 'st$' and env != 'production'", []api.MetricKey{"series_0", "series_1"}},
+		{"describe metrics where env = 'staging' or dc matches 'th'", []api.MetricKey{"series_0", "series_1", "series_2"}},
+	} {
+		a := assert.New(t).Contextf("query=%s", test.query)
+		command, err := Parse(test.query)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		a.EqString(command.Name(), "describe metrics")
+		result, err := command.Execute(ExecutionContext{Backend: nil, API: fakeApi, FetchLimit: 1000, Timeout: 0})
+		if err != nil {
+			a.Errorf("Unexpected error while executing: %s", err.Error())
+			continue
+		}
+		a.Eq(result.Body, test.expected)
+	}
+}
+
+func TestCommand_DescribeTagsAndValues(t *testing.T) {
+	fakeApi := mocks.NewFakeApi()
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=west,env=production,host=a")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=west,env=staging,host=b")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=east,env=production,host=c")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_1", api.ParseTagSet("dc=north,app=mqe")}, emptyGraphiteName)
+
+	for _, test := range []struct {
+		query    string
+		name     string
+		expected []string
+	}{
+		{"describe tags", "describe tags", []string{"app", "dc", "env", "host"}},
+		{"describe tags series_0", "describe tags", []string{"dc", "env", "host"}},
+		{"describe tags series_1", "describe tags", []string{"app", "dc"}},
+		{"describe tags series_0 where host = 'nope'", "describe tags", []string{}},
+		{"describe tags does_not_exist", "describe tags", []string{}},
+		{"describe values dc", "describe values", []string{"east", "north", "west"}},
+		{"describe values dc where dc != 'west'", "describe values", []string{"east", "north"}},
+		{"describe values dc for series_0", "describe values", []string{"east", "west"}},
+		{"describe values host for series_0 where env = 'production'", "describe values", []string{"a", "c"}},
+		{"describe values app for series_0", "describe values", []string{}},
+	} {
+		a := assert.New(t).Contextf("query=%s", test.query)
+		command, err := Parse(test.query)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		a.EqString(command.Name(), test.name)
+		result, err := command.Execute(ExecutionContext{Backend: nil, API: fakeApi, FetchLimit: 1000, Timeout: 0})
+		if err != nil {
+			a.Errorf("Unexpected error while executing: %s", err.Error())
+			continue
+		}
+		a.Eq(result.Body, test.expected)
+	}
+}
+
+func TestCommand_DescribeCardinality(t *testing.T) {
+	fakeApi := mocks.NewFakeApi()
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=west,host=a")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=west,host=b")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_0", api.ParseTagSet("dc=east,host=c")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_1", api.ParseTagSet("dc=north")}, emptyGraphiteName)
+
+	for _, test := range []struct {
+		query    string
+		expected cardinality
+	}{
+		{"describe cardinality", cardinality{
+			TagSets: map[api.MetricKey]int{"series_0": 3, "series_1": 1},
+			Values:  map[string]int{"dc": 3, "host": 3},
+		}},
+		{"describe cardinality series_0", cardinality{
+			TagSets: map[api.MetricKey]int{"series_0": 3},
+			Values:  map[string]int{"dc": 2, "host": 3},
+		}},
+		{"describe cardinality does_not_exist", cardinality{
+			TagSets: map[api.MetricKey]int{"does_not_exist": 0},
+			Values:  map[string]int{},
+		}},
+	} {
+		a := assert.New(t).Contextf("query=%s", test.query)
+		command, err := Parse(test.query)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		a.EqString(command.Name(), "describe cardinality")
+		result, err := command.Execute(ExecutionContext{Backend: nil, API: fakeApi, FetchLimit: 1000, Timeout: 0})
+		if err != nil {
+			a.Errorf("Unexpected error while executing: %s", err.Error())
+			continue
+		}
+		a.Eq(result.Body, test.expected)
+	}
+}
+
 func TestCommand_Select(t *testing.T) {
 	epsilon := 1e-10
 	fakeApi := mocks.NewFakeApi()
@@ -136,6 +256,38 @@ func TestCommand_Select(t *testing.T) {
 			Timerange: testTimerange,
 			Name:      "",
 		}},
+		{"select series_1 > 2 from 0 to 120 resolution '30ms'", false, api.SeriesList{
+			Series: []api.Timeseries{{
+				[]float64{math.NaN(), math.NaN(), 3, 4, 5},
+				api.ParseTagSet("dc=west"),
+			}},
+			Timerange: testTimerange,
+			Name:      "",
+		}},
+		{"select 2 < series_1 from 0 to 120 resolution '30ms'", false, api.SeriesList{
+			Series: []api.Timeseries{{
+				[]float64{math.NaN(), math.NaN(), 3, 4, 5},
+				api.ParseTagSet("dc=west"),
+			}},
+			Timerange: testTimerange,
+			Name:      "",
+		}},
+		{"select series_1 >= bool 3 from 0 to 120 resolution '30ms'", false, api.SeriesList{
+			Series: []api.Timeseries{{
+				[]float64{0, 0, 1, 1, 1},
+				api.ParseTagSet("dc=west"),
+			}},
+			Timerange: testTimerange,
+			Name:      "",
+		}},
+		{"select filter.all_below(series_2, 6) from 0 to 120 resolution '30ms'", false, api.SeriesList{
+			Series: []api.Timeseries{{
+				[]float64{1, 2, 3, 4, 5},
+				api.ParseTagSet("dc=west"),
+			}},
+			Timerange: testTimerange,
+			Name:      "",
+		}},
 		{"select series_1 * 2 from 0 to 120 resolution '30ms'", false, api.SeriesList{
 			Series: []api.Timeseries{{
 				[]float64{2, 4, 6, 8, 10},
@@ -228,7 +380,7 @@ func TestCommand_Select(t *testing.T) {
 				a.Errorf("Unexpected error while executing: %s", err.Error())
 			}
 		} else {
-			casted := rawResult.([]function.Value)
+			casted := rawResult.Body.([]function.Value)
 			actual, _ := casted[0].ToSeriesList(api.Timerange{})
 			a.EqInt(len(actual.Series), len(expected.Series))
 			if len(actual.Series) == len(expected.Series) {
@@ -276,6 +428,157 @@ func TestCommand_Select(t *testing.T) {
 	}
 }
 
+func TestCommand_Pagination(t *testing.T) {
+	fakeApi := mocks.NewFakeApi()
+	fakeApi.AddPair(api.TaggedMetric{"series_2", api.ParseTagSet("dc=east")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_2", api.ParseTagSet("dc=west")}, emptyGraphiteName)
+	fakeBackend := backend.NewSequentialMultiBackend(fakeApiBackend{})
+	for _, test := range []struct {
+		query    string
+		expected []string // expected dc tags, in order
+		metadata map[string]interface{}
+	}{
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by dc",
+			expected: []string{"east", "west"},
+			metadata: map[string]interface{}{"offset": 0, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by dc desc",
+			expected: []string{"west", "east"},
+			metadata: map[string]interface{}{"offset": 0, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by summary(max) desc",
+			expected: []string{"east", "west"},
+			metadata: map[string]interface{}{"offset": 0, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by summary(mean) asc",
+			expected: []string{"east", "west"},
+			metadata: map[string]interface{}{"offset": 0, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by summary(min) desc limit 1",
+			expected: []string{"west"},
+			metadata: map[string]interface{}{"offset": 0, "limit": 1, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by dc limit 1 offset 1",
+			expected: []string{"west"},
+			metadata: map[string]interface{}{"offset": 1, "limit": 1, "total": []int{2}},
+		},
+		{
+			query:    "select series_2 from 0 to 120 resolution 30ms order by dc offset 5",
+			expected: []string{},
+			metadata: map[string]interface{}{"offset": 5, "total": []int{2}},
+		},
+	} {
+		a := assert.New(t).Contextf("query=%s", test.query)
+		command, err := Parse(test.query)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		result, err := command.Execute(ExecutionContext{Backend: fakeBackend, API: fakeApi, FetchLimit: 1000, Timeout: 0})
+		if err != nil {
+			a.Errorf("Unexpected error while executing: %s", err.Error())
+			continue
+		}
+		values := result.Body.([]function.Value)
+		list, _ := values[0].ToSeriesList(api.Timerange{})
+		actual := []string{}
+		for _, series := range list.Series {
+			actual = append(actual, series.TagSet["dc"])
+		}
+		a.Eq(actual, test.expected)
+		a.Eq(result.Metadata, test.metadata)
+	}
+
+	// Without any pagination clause, no metadata is returned.
+	command, err := Parse("select series_2 from 0 to 120 resolution 30ms")
+	if err != nil {
+		t.Fatalf("Unexpected error while parsing")
+	}
+	result, err := command.Execute(ExecutionContext{Backend: fakeBackend, API: fakeApi, FetchLimit: 1000, Timeout: 0})
+	if err != nil {
+		t.Fatalf("Unexpected error while executing: %s", err.Error())
+	}
+	if result.Metadata != nil {
+		t.Errorf("Expected no metadata but got %+v", result.Metadata)
+	}
+}
+
+func TestCommand_MetricPattern(t *testing.T) {
+	fakeApi := mocks.NewFakeApi()
+	fakeApi.AddPair(api.TaggedMetric{"series_1", api.ParseTagSet("dc=west")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_2", api.ParseTagSet("dc=east")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_2", api.ParseTagSet("dc=west")}, emptyGraphiteName)
+	fakeApi.AddPair(api.TaggedMetric{"series_timeout", api.ParseTagSet("dc=west")}, emptyGraphiteName)
+	fakeBackend := backend.NewSequentialMultiBackend(fakeApiBackend{})
+	for _, test := range []struct {
+		query      string
+		expected   []string // expected __name__ and dc tags, in order
+		fetchLimit int
+		expectErr  bool
+	}{
+		{
+			query:    "select `series_?` from 0 to 120 resolution 30ms",
+			expected: []string{},
+		},
+		{
+			query:    "select `series_*` where dc != 'nowhere' and __name__ != 'series_timeout' from 0 to 120 resolution 30ms",
+			expected: []string{"series_1 west", "series_2 east", "series_2 west"},
+		},
+		{
+			query:    "select `series_*`[dc = 'west' and __name__ != 'series_timeout'] from 0 to 120 resolution 30ms",
+			expected: []string{"series_1 west", "series_2 west"},
+		},
+		{
+			query:    "select metric matches '_2$' from 0 to 120 resolution 30ms",
+			expected: []string{"series_2 east", "series_2 west"},
+		},
+		{
+			query:    "select metric matches '^series_[0-9]' [dc = 'east'] from 0 to 120 resolution 30ms",
+			expected: []string{"series_2 east"},
+		},
+		{
+			query:      "select `series_*`[__name__ != 'series_timeout'] from 0 to 120 resolution 30ms",
+			fetchLimit: 2,
+			expectErr:  true,
+		},
+	} {
+		a := assert.New(t).Contextf("query=%s", test.query)
+		command, err := Parse(test.query)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		fetchLimit := test.fetchLimit
+		if fetchLimit == 0 {
+			fetchLimit = 1000
+		}
+		result, err := command.Execute(ExecutionContext{Backend: fakeBackend, API: fakeApi, FetchLimit: fetchLimit, Timeout: 0})
+		if test.expectErr {
+			if err == nil {
+				a.Errorf("Expected an error while executing but got none")
+			}
+			continue
+		}
+		if err != nil {
+			a.Errorf("Unexpected error while executing: %s", err.Error())
+			continue
+		}
+		values := result.Body.([]function.Value)
+		list, _ := values[0].ToSeriesList(api.Timerange{})
+		actual := []string{}
+		for _, series := range list.Series {
+			actual = append(actual, series.TagSet["__name__"]+" "+series.TagSet["dc"])
+		}
+		a.Eq(actual, test.expected)
+	}
+}
+
 func TestNaming(t *testing.T) {
 	fakeApi := mocks.NewFakeApi()
 	fakeBackend := backend.NewSequentialMultiBackend(fakeApiBackend{})
@@ -323,6 +626,26 @@ func TestNaming(t *testing.T) {
 			query:    "select filter.lowest_max(series_2, 6) from 0 to 0",
 			expected: "filter.lowest_max(series_2, 6)",
 		},
+		{
+			query:    "select filter.highest_max(series_2, 1 group by dc) from 0 to 0",
+			expected: "filter.highest_max(series_2, 1 group by dc)",
+		},
+		{
+			query:    "select filter.highest_max(series_2, 1, 'others') from 0 to 0",
+			expected: "filter.highest_max(series_2, 1, others)",
+		},
+		{
+			query:    "select series_1 > 2 from 0 to 0",
+			expected: "(series_1 > 2)",
+		},
+		{
+			query:    "select series_1 <= bool 2 from 0 to 0",
+			expected: "(series_1 <= bool 2)",
+		},
+		{
+			query:    "select filter.any_above(series_2, 2.5) from 0 to 0",
+			expected: "filter.any_above(series_2, 2.5)",
+		},
 	}
 	for _, test := range tests {
 		command, err := Parse(test.query)
@@ -339,7 +662,7 @@ func TestNaming(t *testing.T) {
 			t.Errorf("Unexpected error while execution: %s", err.Error())
 			continue
 		}
-		seriesListList, ok := rawResult.([]function.Value)
+		seriesListList, ok := rawResult.Body.([]function.Value)
 		if !ok || len(seriesListList) != 1 {
 			t.Errorf("expected query `%s` to produce []value; got %+v :: %T", test.query, rawResult, rawResult)
 			continue
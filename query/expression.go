@@ -17,11 +17,16 @@ package query
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/square/metrics/api"
 	"github.com/square/metrics/function"
 )
 
+// metricNameTag is the synthetic tag holding the metric name of series fetched by a pattern.
+const metricNameTag = "__name__"
+
 // Implementations
 // ===============
 
@@ -50,23 +55,29 @@ func (expr *metricFetchExpression) Evaluate(context function.EvaluationContext)
 		predicate = &andPredicate{[]api.Predicate{expr.predicate, context.Predicate}}
 	}
 
-	metricTagSets, err := context.API.GetAllTags(api.MetricKey(expr.metricName))
-	if err != nil {
-		return nil, err
+	var metrics []api.TaggedMetric
+	if expr.pattern == nil {
+		metricTagSets, err := context.API.GetMatchingTags(api.MetricKey(expr.metricName), predicate, activeSince(context))
+		if err != nil {
+			return nil, err
+		}
+		for _, tagset := range metricTagSets {
+			metrics = append(metrics, api.TaggedMetric{api.MetricKey(expr.metricName), tagset})
+		}
+	} else {
+		matching, err := expr.matchingMetrics(context, predicate)
+		if err != nil {
+			return nil, err
+		}
+		metrics = matching
 	}
-	filtered := applyPredicates(metricTagSets, predicate)
 
-	ok := context.FetchLimit.Consume(len(filtered))
+	ok := context.FetchLimit.Consume(len(metrics))
 
 	if !ok {
 		return nil, errors.New("fetch limit exceeded: too many series to fetch")
 	}
 
-	metrics := make([]api.TaggedMetric, len(filtered))
-	for i := range metrics {
-		metrics[i] = api.TaggedMetric{api.MetricKey(expr.metricName), filtered[i]}
-	}
-
 	serieslist, err := context.MultiBackend.FetchMultipleSeries(
 		api.FetchMultipleRequest{
 			metrics,
@@ -82,11 +93,63 @@ func (expr *metricFetchExpression) Evaluate(context function.EvaluationContext)
 		return nil, err
 	}
 
+	if expr.pattern != nil {
+		// Series are returned in the order of the requested metrics.
+		for i := range serieslist.Series {
+			serieslist.Series[i].TagSet = withMetricName(metrics[i])
+		}
+	}
+
 	serieslist.Name = expr.metricName
 
 	return function.SeriesListValue(serieslist), nil
 }
 
+// matchingMetrics finds the tagged metrics whose names match the expression's pattern
+// and whose tags (including the `__name__` tag) satisfy the predicate.
+func (expr *metricFetchExpression) matchingMetrics(context function.EvaluationContext, predicate api.Predicate) ([]api.TaggedMetric, error) {
+	keys, err := context.API.GetAllMetrics()
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, key := range keys {
+		if expr.pattern.MatchString(string(key)) {
+			names = append(names, string(key))
+		}
+	}
+	sort.Strings(names)
+	metrics := []api.TaggedMetric{}
+	for _, name := range names {
+		tagsets, err := context.API.GetAllTags(api.MetricKey(name), activeSince(context))
+		if err != nil {
+			return nil, err
+		}
+		for _, tagset := range tagsets {
+			metric := api.TaggedMetric{api.MetricKey(name), tagset}
+			if predicate.Apply(withMetricName(metric)) {
+				metrics = append(metrics, metric)
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// activeSince is the start of the timerange; series not seen since then have no data to fetch.
+func activeSince(context function.EvaluationContext) time.Time {
+	return time.Unix(0, context.Timerange.Start()*int64(time.Millisecond))
+}
+
+// withMetricName returns a copy of the metric's tagset which includes its name as the `__name__` tag.
+func withMetricName(metric api.TaggedMetric) api.TagSet {
+	tagset := api.NewTagSet()
+	for key, value := range metric.TagSet {
+		tagset[key] = value
+	}
+	tagset[metricNameTag] = string(metric.MetricKey)
+	return tagset
+}
+
 func (expr *functionExpression) Evaluate(context function.EvaluationContext) (function.Value, error) {
 	fun, ok := context.Registry.GetFunction(expr.functionName)
 	if !ok {
@@ -99,16 +162,6 @@ func (expr *functionExpression) Evaluate(context function.EvaluationContext) (fu
 // Auxiliary functions
 // ===================
 
-func applyPredicates(tagSets []api.TagSet, predicate api.Predicate) []api.TagSet {
-	output := []api.TagSet{}
-	for _, ts := range tagSets {
-		if predicate.Apply(ts) {
-			output = append(output, ts)
-		}
-	}
-	return output
-}
-
 // evaluateExpressions evaluates all provided Expressions in the
 // EvaluationContext. If any evaluations error, evaluateExpressions will
 // propagate that error. The resulting SeriesLists will be in an order
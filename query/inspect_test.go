@@ -15,6 +15,7 @@
 package query
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -36,6 +37,16 @@ func (a fakeAPI) RemoveMetric(metric api.TaggedMetric) error {
 	return nil
 }
 
+func (a fakeAPI) AddMetrics(metrics []api.TaggedMetric) []error {
+	// NOTHING
+	return make([]error, len(metrics))
+}
+
+func (a fakeAPI) RemoveMetrics(metrics []api.TaggedMetric) []error {
+	// NOTHING
+	return make([]error, len(metrics))
+}
+
 func (a fakeAPI) ToGraphiteName(metric api.TaggedMetric) (api.GraphiteMetric, error) {
 	return api.GraphiteMetric(metric.MetricKey), nil
 }
@@ -47,10 +58,20 @@ func (a fakeAPI) ToTaggedName(metric api.GraphiteMetric) (api.TaggedMetric, erro
 	}, nil
 }
 
-func (a fakeAPI) GetAllTags(metricKey api.MetricKey) ([]api.TagSet, error) {
+func (a fakeAPI) GetAllTags(metricKey api.MetricKey, activeSince time.Time) ([]api.TagSet, error) {
 	return a.tagSets[string(metricKey)], nil
 }
 
+func (a fakeAPI) GetMatchingTags(metricKey api.MetricKey, predicate api.Predicate, activeSince time.Time) ([]api.TagSet, error) {
+	result := []api.TagSet{}
+	for _, tagSet := range a.tagSets[string(metricKey)] {
+		if predicate.Apply(tagSet) {
+			result = append(result, tagSet)
+		}
+	}
+	return result, nil
+}
+
 func (a fakeAPI) GetAllMetrics() ([]api.MetricKey, error) {
 	list := []api.MetricKey{}
 	for metric := range a.tagSets {
@@ -75,6 +96,40 @@ MetricLoop:
 	return list, nil
 }
 
+func (a fakeAPI) GetAllTagKeys() ([]string, error) {
+	keys := map[string]bool{}
+	for _, tagsets := range a.tagSets {
+		for _, tagset := range tagsets {
+			for key := range tagset {
+				keys[key] = true
+			}
+		}
+	}
+	return sortedKeys(keys), nil
+}
+
+func (a fakeAPI) GetTagValues(tagKey string) ([]string, error) {
+	values := map[string]bool{}
+	for _, tagsets := range a.tagSets {
+		for _, tagset := range tagsets {
+			if value, ok := tagset[tagKey]; ok {
+				values[value] = true
+			}
+		}
+	}
+	return sortedKeys(values), nil
+}
+
+func (a fakeAPI) SearchMetrics(query string, limit int) ([]api.MetricKey, error) {
+	list := []api.MetricKey{}
+	for metric := range a.tagSets {
+		if strings.Contains(metric, query) {
+			list = append(list, api.MetricKey(metric))
+		}
+	}
+	return list, nil
+}
+
 type fakeBackend struct {
 }
 
@@ -122,7 +177,7 @@ func TestProfilerIntegration(t *testing.T) {
 			expected: map[string]int{
 				"select.Execute":      1,
 				"fetchMultipleSeries": 1,
-				"api.GetAllTags":      1,
+				"api.GetMatchingTags": 1,
 				"fetchSingleSeries":   3,
 			},
 		},
@@ -131,7 +186,7 @@ func TestProfilerIntegration(t *testing.T) {
 			expected: map[string]int{
 				"select.Execute":      1,
 				"fetchMultipleSeries": 2,
-				"api.GetAllTags":      2,
+				"api.GetMatchingTags": 2,
 				"fetchSingleSeries":   6,
 			},
 		},
@@ -140,7 +195,7 @@ func TestProfilerIntegration(t *testing.T) {
 			expected: map[string]int{
 				"select.Execute":      1,
 				"fetchMultipleSeries": 1,
-				"api.GetAllTags":      1,
+				"api.GetMatchingTags": 1,
 				"fetchSingleSeries":   3,
 			},
 		},
@@ -149,15 +204,15 @@ func TestProfilerIntegration(t *testing.T) {
 			expected: map[string]int{
 				"select.Execute":      1,
 				"fetchMultipleSeries": 1,
-				"api.GetAllTags":      1,
+				"api.GetMatchingTags": 1,
 				"fetchSingleSeries":   2,
 			},
 		},
 		{
 			query: "describe A",
 			expected: map[string]int{
-				"describe.Execute": 1,
-				"api.GetAllTags":   1,
+				"describe.Execute":    1,
+				"api.GetMatchingTags": 1,
 			},
 		},
 		{
@@ -167,6 +222,29 @@ func TestProfilerIntegration(t *testing.T) {
 				"api.GetMetricsForTag":     1,
 			},
 		},
+		{
+			query: "describe metrics where y='2' or q in ('foo', 'bar')",
+			expected: map[string]int{
+				"describe metrics.Execute": 1,
+				"api.GetMetricsForTag":     3,
+			},
+		},
+		{
+			query: "describe metrics where y='2' and not x='1'",
+			expected: map[string]int{
+				"describe metrics.Execute": 1,
+				"api.GetMetricsForTag":     1,
+				"api.GetAllTags":           1,
+			},
+		},
+		{
+			query: "describe metrics where c matches '[0-9]'",
+			expected: map[string]int{
+				"describe metrics.Execute": 1,
+				"api.GetAllMetrics":        1,
+				"api.GetAllTags":           3,
+			},
+		},
 		{
 			query: "describe all",
 			expected: map[string]int{
@@ -19,17 +19,23 @@ const (
 	ruledescribeStmt
 	ruledescribeAllStmt
 	ruledescribeMetrics
+	ruledescribeTagsStmt
+	ruledescribeValuesStmt
+	ruledescribeCardinalityStmt
 	ruledescribeSingleStmt
 	rulepropertyClause
+	rulepaginationClause
 	ruleoptionalPredicateClause
 	ruleexpressionList
 	ruleexpression_start
+	ruleexpression_comparison
 	ruleexpression_sum
 	ruleexpression_product
 	ruleadd_pipe
 	ruleexpression_atom
 	ruleexpression_function
 	ruleexpression_metric
+	ruleexpression_metric_pattern
 	rulegroupByClause
 	rulepredicateClause
 	rulepredicate_1
@@ -56,6 +62,13 @@ const (
 	ruleOP_SUB
 	ruleOP_MULT
 	ruleOP_DIV
+	ruleOP_GE
+	ruleOP_LE
+	ruleOP_EQ
+	ruleOP_NE
+	ruleOP_GT
+	ruleOP_LT
+	ruleOP_BOOL
 	ruleOP_AND
 	ruleOP_OR
 	ruleOP_NOT
@@ -124,6 +137,32 @@ const (
 	ruleAction44
 	ruleAction45
 	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
+	ruleAction68
+	ruleAction69
+	ruleAction70
+	ruleAction71
+	ruleAction72
 
 	rulePre_
 	rule_In_
@@ -137,17 +176,23 @@ var rul3s = [...]string{
 	"describeStmt",
 	"describeAllStmt",
 	"describeMetrics",
+	"describeTagsStmt",
+	"describeValuesStmt",
+	"describeCardinalityStmt",
 	"describeSingleStmt",
 	"propertyClause",
+	"paginationClause",
 	"optionalPredicateClause",
 	"expressionList",
 	"expression_start",
+	"expression_comparison",
 	"expression_sum",
 	"expression_product",
 	"add_pipe",
 	"expression_atom",
 	"expression_function",
 	"expression_metric",
+	"expression_metric_pattern",
 	"groupByClause",
 	"predicateClause",
 	"predicate_1",
@@ -174,6 +219,13 @@ var rul3s = [...]string{
 	"OP_SUB",
 	"OP_MULT",
 	"OP_DIV",
+	"OP_GE",
+	"OP_LE",
+	"OP_EQ",
+	"OP_NE",
+	"OP_GT",
+	"OP_LT",
+	"OP_BOOL",
 	"OP_AND",
 	"OP_OR",
 	"OP_NOT",
@@ -242,6 +294,32 @@ var rul3s = [...]string{
 	"Action44",
 	"Action45",
 	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
+	"Action68",
+	"Action69",
+	"Action70",
+	"Action71",
+	"Action72",
 
 	"Pre_",
 	"_In_",
@@ -579,7 +657,7 @@ type Parser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [111]func() bool
+	rules  [150]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	tokenTree
@@ -668,122 +746,183 @@ func (p *Parser) Execute() {
 		case ruleAction3:
 			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		case ruleAction4:
-			p.makeDescribe()
+
+			p.addStringLiteral("")
+			p.addNullPredicate()
+
 		case ruleAction5:
-			p.addEvaluationContext()
+			p.makeDescribeTags()
 		case ruleAction6:
-			p.addPropertyKey(buffer[begin:end])
+			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		case ruleAction7:
-			p.addPropertyValue(buffer[begin:end])
+			p.addStringLiteral("")
 		case ruleAction8:
-			p.insertPropertyKeyValue()
+			p.makeDescribeValues()
 		case ruleAction9:
-			p.checkPropertyClause()
+			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		case ruleAction10:
-			p.addNullPredicate()
+			p.addStringLiteral("")
 		case ruleAction11:
-			p.addExpressionList()
+			p.makeDescribeCardinality()
 		case ruleAction12:
-			p.appendExpression()
+			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		case ruleAction13:
-			p.appendExpression()
+			p.makeDescribe()
 		case ruleAction14:
-			p.addOperatorLiteral("+")
+			p.addEvaluationContext()
 		case ruleAction15:
-			p.addOperatorLiteral("-")
+			p.addPropertyKey(buffer[begin:end])
 		case ruleAction16:
-			p.addOperatorFunction()
+			p.addPropertyValue(buffer[begin:end])
 		case ruleAction17:
-			p.addOperatorLiteral("/")
+			p.insertPropertyKeyValue()
 		case ruleAction18:
-			p.addOperatorLiteral("*")
+			p.checkPropertyClause()
 		case ruleAction19:
-			p.addOperatorFunction()
+			p.addPagination()
 		case ruleAction20:
 
-			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
+			p.setOrderSummary(buffer[begin:end])
 
 		case ruleAction21:
-			p.addExpressionList()
+			p.setOrderTag(unescapeLiteral(buffer[begin:end]))
 		case ruleAction22:
-			p.addGroupBy()
+			p.setOrderDescending()
 		case ruleAction23:
+			p.setLimit(buffer[begin:end])
+		case ruleAction24:
+			p.setOffset(buffer[begin:end])
+		case ruleAction25:
+			p.addNullPredicate()
+		case ruleAction26:
+			p.addExpressionList()
+		case ruleAction27:
+			p.appendExpression()
+		case ruleAction28:
+			p.appendExpression()
+		case ruleAction29:
+			p.addOperatorLiteral(">=")
+		case ruleAction30:
+			p.addOperatorLiteral("<=")
+		case ruleAction31:
+			p.addOperatorLiteral("==")
+		case ruleAction32:
+			p.addOperatorLiteral("!=")
+		case ruleAction33:
+			p.addOperatorLiteral(">")
+		case ruleAction34:
+			p.addOperatorLiteral("<")
+		case ruleAction35:
+			p.addBooleanModifier()
+		case ruleAction36:
+			p.addOperatorFunction()
+		case ruleAction37:
+			p.addOperatorLiteral("+")
+		case ruleAction38:
+			p.addOperatorLiteral("-")
+		case ruleAction39:
+			p.addOperatorFunction()
+		case ruleAction40:
+			p.addOperatorLiteral("/")
+		case ruleAction41:
+			p.addOperatorLiteral("*")
+		case ruleAction42:
+			p.addOperatorFunction()
+		case ruleAction43:
+
+			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 
+		case ruleAction44:
 			p.addExpressionList()
+		case ruleAction45:
 			p.addGroupBy()
+		case ruleAction46:
 
-		case ruleAction24:
+			p.addExpressionList()
+			p.addGroupBy()
+
+		case ruleAction47:
 
 			p.addPipeExpression()
 
-		case ruleAction25:
+		case ruleAction48:
 			p.addDurationNode(text)
-		case ruleAction26:
+		case ruleAction49:
 			p.addNumberNode(buffer[begin:end])
-		case ruleAction27:
+		case ruleAction50:
 			p.addStringNode(unescapeLiteral(buffer[begin:end]))
-		case ruleAction28:
+		case ruleAction51:
 
 			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction29:
+		case ruleAction52:
 			p.addGroupBy()
-		case ruleAction30:
+		case ruleAction53:
 
 			p.addFunctionInvocation()
 
-		case ruleAction31:
+		case ruleAction54:
 
 			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction32:
+		case ruleAction55:
 			p.addNullPredicate()
-		case ruleAction33:
+		case ruleAction56:
 
 			p.addMetricExpression()
 
-		case ruleAction34:
+		case ruleAction57:
+
+			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
+
+		case ruleAction58:
+			p.addNullPredicate()
+		case ruleAction59:
+
+			p.addMetricPatternExpression()
+
+		case ruleAction60:
 
 			p.appendGroupBy(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction35:
+		case ruleAction61:
 
 			p.appendGroupBy(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction36:
+		case ruleAction62:
 			p.addOrPredicate()
-		case ruleAction37:
+		case ruleAction63:
 			p.addAndPredicate()
-		case ruleAction38:
+		case ruleAction64:
 			p.addNotPredicate()
-		case ruleAction39:
+		case ruleAction65:
 
 			p.addLiteralMatcher()
 
-		case ruleAction40:
+		case ruleAction66:
 
 			p.addLiteralMatcher()
 			p.addNotPredicate()
 
-		case ruleAction41:
+		case ruleAction67:
 
 			p.addRegexMatcher()
 
-		case ruleAction42:
+		case ruleAction68:
 
 			p.addListMatcher()
 
-		case ruleAction43:
+		case ruleAction69:
 
 			p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction44:
+		case ruleAction70:
 			p.addLiteralList()
-		case ruleAction45:
+		case ruleAction71:
 
 			p.appendLiteral(unescapeLiteral(buffer[begin:end]))
 
-		case ruleAction46:
+		case ruleAction72:
 			p.addTagLiteral(unescapeLiteral(buffer[begin:end]))
 
 		}
@@ -976,7 +1115,7 @@ func (p *Parser) Init() {
 							position19 := position
 							depth++
 							{
-								add(ruleAction5, position)
+								add(ruleAction14, position)
 							}
 						l21:
 							{
@@ -988,7 +1127,7 @@ func (p *Parser) Init() {
 									goto l22
 								}
 								{
-									add(ruleAction6, position)
+									add(ruleAction15, position)
 								}
 								if !_rules[rule_]() {
 									goto l22
@@ -1110,478 +1249,1530 @@ func (p *Parser) Init() {
 									add(rulePROPERTY_VALUE, position24)
 								}
 								{
-									add(ruleAction7, position)
+									add(ruleAction16, position)
 								}
 								{
-									add(ruleAction8, position)
+									add(ruleAction17, position)
 								}
 								goto l21
 							l22:
 								position, tokenIndex, depth = position22, tokenIndex22, depth22
 							}
 							{
-								add(ruleAction9, position)
+								add(ruleAction18, position)
 							}
 							depth--
 							add(rulepropertyClause, position19)
 						}
 						{
-							add(ruleAction0, position)
-						}
-						depth--
-						add(ruleselectStmt, position4)
-					}
-					goto l2
-				l3:
-					position, tokenIndex, depth = position2, tokenIndex2, depth2
-					{
-						position45 := position
-						depth++
-						if !_rules[rule_]() {
-							goto l0
-						}
-						{
-							position46, tokenIndex46, depth46 := position, tokenIndex, depth
-							if buffer[position] != rune('d') {
-								goto l47
-							}
-							position++
-							goto l46
-						l47:
-							position, tokenIndex, depth = position46, tokenIndex46, depth46
-							if buffer[position] != rune('D') {
-								goto l0
-							}
-							position++
-						}
-					l46:
-						{
-							position48, tokenIndex48, depth48 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l49
-							}
-							position++
-							goto l48
-						l49:
-							position, tokenIndex, depth = position48, tokenIndex48, depth48
-							if buffer[position] != rune('E') {
-								goto l0
-							}
-							position++
-						}
-					l48:
-						{
-							position50, tokenIndex50, depth50 := position, tokenIndex, depth
-							if buffer[position] != rune('s') {
-								goto l51
-							}
-							position++
-							goto l50
-						l51:
-							position, tokenIndex, depth = position50, tokenIndex50, depth50
-							if buffer[position] != rune('S') {
-								goto l0
-							}
-							position++
-						}
-					l50:
-						{
-							position52, tokenIndex52, depth52 := position, tokenIndex, depth
-							if buffer[position] != rune('c') {
-								goto l53
-							}
-							position++
-							goto l52
-						l53:
-							position, tokenIndex, depth = position52, tokenIndex52, depth52
-							if buffer[position] != rune('C') {
-								goto l0
-							}
-							position++
-						}
-					l52:
-						{
-							position54, tokenIndex54, depth54 := position, tokenIndex, depth
-							if buffer[position] != rune('r') {
-								goto l55
-							}
-							position++
-							goto l54
-						l55:
-							position, tokenIndex, depth = position54, tokenIndex54, depth54
-							if buffer[position] != rune('R') {
-								goto l0
-							}
-							position++
-						}
-					l54:
-						{
-							position56, tokenIndex56, depth56 := position, tokenIndex, depth
-							if buffer[position] != rune('i') {
-								goto l57
-							}
-							position++
-							goto l56
-						l57:
-							position, tokenIndex, depth = position56, tokenIndex56, depth56
-							if buffer[position] != rune('I') {
-								goto l0
-							}
-							position++
-						}
-					l56:
-						{
-							position58, tokenIndex58, depth58 := position, tokenIndex, depth
-							if buffer[position] != rune('b') {
-								goto l59
-							}
-							position++
-							goto l58
-						l59:
-							position, tokenIndex, depth = position58, tokenIndex58, depth58
-							if buffer[position] != rune('B') {
-								goto l0
-							}
-							position++
-						}
-					l58:
-						{
-							position60, tokenIndex60, depth60 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l61
-							}
-							position++
-							goto l60
-						l61:
-							position, tokenIndex, depth = position60, tokenIndex60, depth60
-							if buffer[position] != rune('E') {
-								goto l0
+							position44 := position
+							depth++
+							{
+								add(ruleAction19, position)
 							}
-							position++
-						}
-					l60:
-						if !_rules[ruleKEY]() {
-							goto l0
-						}
-						{
-							position62, tokenIndex62, depth62 := position, tokenIndex, depth
 							{
-								position64 := position
-								depth++
+								position46, tokenIndex46, depth46 := position, tokenIndex, depth
 								if !_rules[rule_]() {
-									goto l63
-								}
-								{
-									position65, tokenIndex65, depth65 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l66
-									}
-									position++
-									goto l65
-								l66:
-									position, tokenIndex, depth = position65, tokenIndex65, depth65
-									if buffer[position] != rune('A') {
-										goto l63
-									}
-									position++
+									goto l46
 								}
-							l65:
 								{
-									position67, tokenIndex67, depth67 := position, tokenIndex, depth
-									if buffer[position] != rune('l') {
-										goto l68
+									position48, tokenIndex48, depth48 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l49
 									}
 									position++
-									goto l67
-								l68:
-									position, tokenIndex, depth = position67, tokenIndex67, depth67
-									if buffer[position] != rune('L') {
-										goto l63
+									goto l48
+								l49:
+									position, tokenIndex, depth = position48, tokenIndex48, depth48
+									if buffer[position] != rune('O') {
+										goto l46
 									}
 									position++
 								}
-							l67:
+							l48:
 								{
-									position69, tokenIndex69, depth69 := position, tokenIndex, depth
-									if buffer[position] != rune('l') {
-										goto l70
+									position50, tokenIndex50, depth50 := position, tokenIndex, depth
+									if buffer[position] != rune('r') {
+										goto l51
 									}
 									position++
-									goto l69
-								l70:
-									position, tokenIndex, depth = position69, tokenIndex69, depth69
-									if buffer[position] != rune('L') {
-										goto l63
+									goto l50
+								l51:
+									position, tokenIndex, depth = position50, tokenIndex50, depth50
+									if buffer[position] != rune('R') {
+										goto l46
 									}
 									position++
 								}
-							l69:
-								if !_rules[ruleKEY]() {
-									goto l63
-								}
-								{
-									add(ruleAction1, position)
-								}
-								depth--
-								add(ruledescribeAllStmt, position64)
-							}
-							goto l62
-						l63:
-							position, tokenIndex, depth = position62, tokenIndex62, depth62
-							{
-								position73 := position
-								depth++
-								if !_rules[rule_]() {
-									goto l72
-								}
+							l50:
 								{
-									position74, tokenIndex74, depth74 := position, tokenIndex, depth
-									if buffer[position] != rune('m') {
-										goto l75
+									position52, tokenIndex52, depth52 := position, tokenIndex, depth
+									if buffer[position] != rune('d') {
+										goto l53
 									}
 									position++
-									goto l74
-								l75:
-									position, tokenIndex, depth = position74, tokenIndex74, depth74
-									if buffer[position] != rune('M') {
-										goto l72
+									goto l52
+								l53:
+									position, tokenIndex, depth = position52, tokenIndex52, depth52
+									if buffer[position] != rune('D') {
+										goto l46
 									}
 									position++
 								}
-							l74:
+							l52:
 								{
-									position76, tokenIndex76, depth76 := position, tokenIndex, depth
+									position54, tokenIndex54, depth54 := position, tokenIndex, depth
 									if buffer[position] != rune('e') {
-										goto l77
+										goto l55
 									}
 									position++
-									goto l76
-								l77:
-									position, tokenIndex, depth = position76, tokenIndex76, depth76
+									goto l54
+								l55:
+									position, tokenIndex, depth = position54, tokenIndex54, depth54
 									if buffer[position] != rune('E') {
-										goto l72
-									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l79
-									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
-									if buffer[position] != rune('T') {
-										goto l72
+										goto l46
 									}
 									position++
 								}
-							l78:
+							l54:
 								{
-									position80, tokenIndex80, depth80 := position, tokenIndex, depth
+									position56, tokenIndex56, depth56 := position, tokenIndex, depth
 									if buffer[position] != rune('r') {
-										goto l81
+										goto l57
 									}
 									position++
-									goto l80
-								l81:
-									position, tokenIndex, depth = position80, tokenIndex80, depth80
+									goto l56
+								l57:
+									position, tokenIndex, depth = position56, tokenIndex56, depth56
 									if buffer[position] != rune('R') {
-										goto l72
+										goto l46
 									}
 									position++
 								}
-							l80:
+							l56:
+								if !_rules[ruleKEY]() {
+									goto l46
+								}
+								if !_rules[rule_]() {
+									goto l46
+								}
 								{
-									position82, tokenIndex82, depth82 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l83
+									position58, tokenIndex58, depth58 := position, tokenIndex, depth
+									if buffer[position] != rune('b') {
+										goto l59
 									}
 									position++
-									goto l82
-								l83:
-									position, tokenIndex, depth = position82, tokenIndex82, depth82
-									if buffer[position] != rune('I') {
-										goto l72
+									goto l58
+								l59:
+									position, tokenIndex, depth = position58, tokenIndex58, depth58
+									if buffer[position] != rune('B') {
+										goto l46
 									}
 									position++
 								}
-							l82:
+							l58:
 								{
-									position84, tokenIndex84, depth84 := position, tokenIndex, depth
-									if buffer[position] != rune('c') {
-										goto l85
+									position60, tokenIndex60, depth60 := position, tokenIndex, depth
+									if buffer[position] != rune('y') {
+										goto l61
 									}
 									position++
-									goto l84
-								l85:
-									position, tokenIndex, depth = position84, tokenIndex84, depth84
-									if buffer[position] != rune('C') {
-										goto l72
+									goto l60
+								l61:
+									position, tokenIndex, depth = position60, tokenIndex60, depth60
+									if buffer[position] != rune('Y') {
+										goto l46
 									}
 									position++
 								}
-							l84:
+							l60:
+								if !_rules[ruleKEY]() {
+									goto l46
+								}
+								{
+									position62, tokenIndex62, depth62 := position, tokenIndex, depth
+									if !_rules[rule_]() {
+										goto l63
+									}
+									{
+										position64, tokenIndex64, depth64 := position, tokenIndex, depth
+										if buffer[position] != rune('s') {
+											goto l65
+										}
+										position++
+										goto l64
+									l65:
+										position, tokenIndex, depth = position64, tokenIndex64, depth64
+										if buffer[position] != rune('S') {
+											goto l63
+										}
+										position++
+									}
+								l64:
+									{
+										position66, tokenIndex66, depth66 := position, tokenIndex, depth
+										if buffer[position] != rune('u') {
+											goto l67
+										}
+										position++
+										goto l66
+									l67:
+										position, tokenIndex, depth = position66, tokenIndex66, depth66
+										if buffer[position] != rune('U') {
+											goto l63
+										}
+										position++
+									}
+								l66:
+									{
+										position68, tokenIndex68, depth68 := position, tokenIndex, depth
+										if buffer[position] != rune('m') {
+											goto l69
+										}
+										position++
+										goto l68
+									l69:
+										position, tokenIndex, depth = position68, tokenIndex68, depth68
+										if buffer[position] != rune('M') {
+											goto l63
+										}
+										position++
+									}
+								l68:
+									{
+										position70, tokenIndex70, depth70 := position, tokenIndex, depth
+										if buffer[position] != rune('m') {
+											goto l71
+										}
+										position++
+										goto l70
+									l71:
+										position, tokenIndex, depth = position70, tokenIndex70, depth70
+										if buffer[position] != rune('M') {
+											goto l63
+										}
+										position++
+									}
+								l70:
+									{
+										position72, tokenIndex72, depth72 := position, tokenIndex, depth
+										if buffer[position] != rune('a') {
+											goto l73
+										}
+										position++
+										goto l72
+									l73:
+										position, tokenIndex, depth = position72, tokenIndex72, depth72
+										if buffer[position] != rune('A') {
+											goto l63
+										}
+										position++
+									}
+								l72:
+									{
+										position74, tokenIndex74, depth74 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
+											goto l75
+										}
+										position++
+										goto l74
+									l75:
+										position, tokenIndex, depth = position74, tokenIndex74, depth74
+										if buffer[position] != rune('R') {
+											goto l63
+										}
+										position++
+									}
+								l74:
+									{
+										position76, tokenIndex76, depth76 := position, tokenIndex, depth
+										if buffer[position] != rune('y') {
+											goto l77
+										}
+										position++
+										goto l76
+									l77:
+										position, tokenIndex, depth = position76, tokenIndex76, depth76
+										if buffer[position] != rune('Y') {
+											goto l63
+										}
+										position++
+									}
+								l76:
+									if !_rules[rule_]() {
+										goto l63
+									}
+									if !_rules[rulePAREN_OPEN]() {
+										goto l63
+									}
+									if !_rules[rule_]() {
+										goto l63
+									}
+									{
+										position78 := position
+										depth++
+										if !_rules[ruleIDENTIFIER]() {
+											goto l63
+										}
+										depth--
+										add(rulePegText, position78)
+									}
+									if !_rules[rule_]() {
+										goto l63
+									}
+									if !_rules[rulePAREN_CLOSE]() {
+										goto l63
+									}
+									{
+										add(ruleAction20, position)
+									}
+									goto l62
+								l63:
+									position, tokenIndex, depth = position62, tokenIndex62, depth62
+									if !_rules[rule_]() {
+										goto l46
+									}
+									{
+										position80 := position
+										depth++
+										if !_rules[ruleTAG_NAME]() {
+											goto l46
+										}
+										depth--
+										add(rulePegText, position80)
+									}
+									{
+										add(ruleAction21, position)
+									}
+								}
+							l62:
+								{
+									position82, tokenIndex82, depth82 := position, tokenIndex, depth
+									{
+										position84, tokenIndex84, depth84 := position, tokenIndex, depth
+										if !_rules[rule_]() {
+											goto l85
+										}
+										{
+											position86, tokenIndex86, depth86 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l87
+											}
+											position++
+											goto l86
+										l87:
+											position, tokenIndex, depth = position86, tokenIndex86, depth86
+											if buffer[position] != rune('A') {
+												goto l85
+											}
+											position++
+										}
+									l86:
+										{
+											position88, tokenIndex88, depth88 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l89
+											}
+											position++
+											goto l88
+										l89:
+											position, tokenIndex, depth = position88, tokenIndex88, depth88
+											if buffer[position] != rune('S') {
+												goto l85
+											}
+											position++
+										}
+									l88:
+										{
+											position90, tokenIndex90, depth90 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l91
+											}
+											position++
+											goto l90
+										l91:
+											position, tokenIndex, depth = position90, tokenIndex90, depth90
+											if buffer[position] != rune('C') {
+												goto l85
+											}
+											position++
+										}
+									l90:
+										if !_rules[ruleKEY]() {
+											goto l85
+										}
+										goto l84
+									l85:
+										position, tokenIndex, depth = position84, tokenIndex84, depth84
+										if !_rules[rule_]() {
+											goto l82
+										}
+										{
+											position92, tokenIndex92, depth92 := position, tokenIndex, depth
+											if buffer[position] != rune('d') {
+												goto l93
+											}
+											position++
+											goto l92
+										l93:
+											position, tokenIndex, depth = position92, tokenIndex92, depth92
+											if buffer[position] != rune('D') {
+												goto l82
+											}
+											position++
+										}
+									l92:
+										{
+											position94, tokenIndex94, depth94 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l95
+											}
+											position++
+											goto l94
+										l95:
+											position, tokenIndex, depth = position94, tokenIndex94, depth94
+											if buffer[position] != rune('E') {
+												goto l82
+											}
+											position++
+										}
+									l94:
+										{
+											position96, tokenIndex96, depth96 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l97
+											}
+											position++
+											goto l96
+										l97:
+											position, tokenIndex, depth = position96, tokenIndex96, depth96
+											if buffer[position] != rune('S') {
+												goto l82
+											}
+											position++
+										}
+									l96:
+										{
+											position98, tokenIndex98, depth98 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l99
+											}
+											position++
+											goto l98
+										l99:
+											position, tokenIndex, depth = position98, tokenIndex98, depth98
+											if buffer[position] != rune('C') {
+												goto l82
+											}
+											position++
+										}
+									l98:
+										if !_rules[ruleKEY]() {
+											goto l82
+										}
+										{
+											add(ruleAction22, position)
+										}
+									}
+								l84:
+									goto l83
+								l82:
+									position, tokenIndex, depth = position82, tokenIndex82, depth82
+								}
+							l83:
+								goto l47
+							l46:
+								position, tokenIndex, depth = position46, tokenIndex46, depth46
+							}
+						l47:
+							{
+								position101, tokenIndex101, depth101 := position, tokenIndex, depth
+								if !_rules[rule_]() {
+									goto l101
+								}
+								{
+									position103, tokenIndex103, depth103 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l104
+									}
+									position++
+									goto l103
+								l104:
+									position, tokenIndex, depth = position103, tokenIndex103, depth103
+									if buffer[position] != rune('L') {
+										goto l101
+									}
+									position++
+								}
+							l103:
+								{
+									position105, tokenIndex105, depth105 := position, tokenIndex, depth
+									if buffer[position] != rune('i') {
+										goto l106
+									}
+									position++
+									goto l105
+								l106:
+									position, tokenIndex, depth = position105, tokenIndex105, depth105
+									if buffer[position] != rune('I') {
+										goto l101
+									}
+									position++
+								}
+							l105:
+								{
+									position107, tokenIndex107, depth107 := position, tokenIndex, depth
+									if buffer[position] != rune('m') {
+										goto l108
+									}
+									position++
+									goto l107
+								l108:
+									position, tokenIndex, depth = position107, tokenIndex107, depth107
+									if buffer[position] != rune('M') {
+										goto l101
+									}
+									position++
+								}
+							l107:
+								{
+									position109, tokenIndex109, depth109 := position, tokenIndex, depth
+									if buffer[position] != rune('i') {
+										goto l110
+									}
+									position++
+									goto l109
+								l110:
+									position, tokenIndex, depth = position109, tokenIndex109, depth109
+									if buffer[position] != rune('I') {
+										goto l101
+									}
+									position++
+								}
+							l109:
+								{
+									position111, tokenIndex111, depth111 := position, tokenIndex, depth
+									if buffer[position] != rune('t') {
+										goto l112
+									}
+									position++
+									goto l111
+								l112:
+									position, tokenIndex, depth = position111, tokenIndex111, depth111
+									if buffer[position] != rune('T') {
+										goto l101
+									}
+									position++
+								}
+							l111:
+								if !_rules[ruleKEY]() {
+									goto l101
+								}
+								if !_rules[rule_]() {
+									goto l101
+								}
+								{
+									position113 := position
+									depth++
+									if !_rules[ruleNUMBER_NATURAL]() {
+										goto l101
+									}
+									depth--
+									add(rulePegText, position113)
+								}
+								{
+									add(ruleAction23, position)
+								}
+								goto l102
+							l101:
+								position, tokenIndex, depth = position101, tokenIndex101, depth101
+							}
+						l102:
+							{
+								position115, tokenIndex115, depth115 := position, tokenIndex, depth
+								if !_rules[rule_]() {
+									goto l115
+								}
+								{
+									position117, tokenIndex117, depth117 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l118
+									}
+									position++
+									goto l117
+								l118:
+									position, tokenIndex, depth = position117, tokenIndex117, depth117
+									if buffer[position] != rune('O') {
+										goto l115
+									}
+									position++
+								}
+							l117:
+								{
+									position119, tokenIndex119, depth119 := position, tokenIndex, depth
+									if buffer[position] != rune('f') {
+										goto l120
+									}
+									position++
+									goto l119
+								l120:
+									position, tokenIndex, depth = position119, tokenIndex119, depth119
+									if buffer[position] != rune('F') {
+										goto l115
+									}
+									position++
+								}
+							l119:
+								{
+									position121, tokenIndex121, depth121 := position, tokenIndex, depth
+									if buffer[position] != rune('f') {
+										goto l122
+									}
+									position++
+									goto l121
+								l122:
+									position, tokenIndex, depth = position121, tokenIndex121, depth121
+									if buffer[position] != rune('F') {
+										goto l115
+									}
+									position++
+								}
+							l121:
+								{
+									position123, tokenIndex123, depth123 := position, tokenIndex, depth
+									if buffer[position] != rune('s') {
+										goto l124
+									}
+									position++
+									goto l123
+								l124:
+									position, tokenIndex, depth = position123, tokenIndex123, depth123
+									if buffer[position] != rune('S') {
+										goto l115
+									}
+									position++
+								}
+							l123:
+								{
+									position125, tokenIndex125, depth125 := position, tokenIndex, depth
+									if buffer[position] != rune('e') {
+										goto l126
+									}
+									position++
+									goto l125
+								l126:
+									position, tokenIndex, depth = position125, tokenIndex125, depth125
+									if buffer[position] != rune('E') {
+										goto l115
+									}
+									position++
+								}
+							l125:
+								{
+									position127, tokenIndex127, depth127 := position, tokenIndex, depth
+									if buffer[position] != rune('t') {
+										goto l128
+									}
+									position++
+									goto l127
+								l128:
+									position, tokenIndex, depth = position127, tokenIndex127, depth127
+									if buffer[position] != rune('T') {
+										goto l115
+									}
+									position++
+								}
+							l127:
+								if !_rules[ruleKEY]() {
+									goto l115
+								}
+								if !_rules[rule_]() {
+									goto l115
+								}
+								{
+									position129 := position
+									depth++
+									if !_rules[ruleNUMBER_NATURAL]() {
+										goto l115
+									}
+									depth--
+									add(rulePegText, position129)
+								}
+								{
+									add(ruleAction24, position)
+								}
+								goto l116
+							l115:
+								position, tokenIndex, depth = position115, tokenIndex115, depth115
+							}
+						l116:
+							depth--
+							add(rulepaginationClause, position44)
+						}
+						{
+							add(ruleAction0, position)
+						}
+						depth--
+						add(ruleselectStmt, position4)
+					}
+					goto l2
+				l3:
+					position, tokenIndex, depth = position2, tokenIndex2, depth2
+					{
+						position132 := position
+						depth++
+						if !_rules[rule_]() {
+							goto l0
+						}
+						{
+							position133, tokenIndex133, depth133 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l134
+							}
+							position++
+							goto l133
+						l134:
+							position, tokenIndex, depth = position133, tokenIndex133, depth133
+							if buffer[position] != rune('D') {
+								goto l0
+							}
+							position++
+						}
+					l133:
+						{
+							position135, tokenIndex135, depth135 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l136
+							}
+							position++
+							goto l135
+						l136:
+							position, tokenIndex, depth = position135, tokenIndex135, depth135
+							if buffer[position] != rune('E') {
+								goto l0
+							}
+							position++
+						}
+					l135:
+						{
+							position137, tokenIndex137, depth137 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l138
+							}
+							position++
+							goto l137
+						l138:
+							position, tokenIndex, depth = position137, tokenIndex137, depth137
+							if buffer[position] != rune('S') {
+								goto l0
+							}
+							position++
+						}
+					l137:
+						{
+							position139, tokenIndex139, depth139 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l140
+							}
+							position++
+							goto l139
+						l140:
+							position, tokenIndex, depth = position139, tokenIndex139, depth139
+							if buffer[position] != rune('C') {
+								goto l0
+							}
+							position++
+						}
+					l139:
+						{
+							position141, tokenIndex141, depth141 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l142
+							}
+							position++
+							goto l141
+						l142:
+							position, tokenIndex, depth = position141, tokenIndex141, depth141
+							if buffer[position] != rune('R') {
+								goto l0
+							}
+							position++
+						}
+					l141:
+						{
+							position143, tokenIndex143, depth143 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l144
+							}
+							position++
+							goto l143
+						l144:
+							position, tokenIndex, depth = position143, tokenIndex143, depth143
+							if buffer[position] != rune('I') {
+								goto l0
+							}
+							position++
+						}
+					l143:
+						{
+							position145, tokenIndex145, depth145 := position, tokenIndex, depth
+							if buffer[position] != rune('b') {
+								goto l146
+							}
+							position++
+							goto l145
+						l146:
+							position, tokenIndex, depth = position145, tokenIndex145, depth145
+							if buffer[position] != rune('B') {
+								goto l0
+							}
+							position++
+						}
+					l145:
+						{
+							position147, tokenIndex147, depth147 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l148
+							}
+							position++
+							goto l147
+						l148:
+							position, tokenIndex, depth = position147, tokenIndex147, depth147
+							if buffer[position] != rune('E') {
+								goto l0
+							}
+							position++
+						}
+					l147:
+						if !_rules[ruleKEY]() {
+							goto l0
+						}
+						{
+							position149, tokenIndex149, depth149 := position, tokenIndex, depth
+							{
+								position151 := position
+								depth++
+								if !_rules[rule_]() {
+									goto l150
+								}
+								{
+									position152, tokenIndex152, depth152 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l153
+									}
+									position++
+									goto l152
+								l153:
+									position, tokenIndex, depth = position152, tokenIndex152, depth152
+									if buffer[position] != rune('A') {
+										goto l150
+									}
+									position++
+								}
+							l152:
+								{
+									position154, tokenIndex154, depth154 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l155
+									}
+									position++
+									goto l154
+								l155:
+									position, tokenIndex, depth = position154, tokenIndex154, depth154
+									if buffer[position] != rune('L') {
+										goto l150
+									}
+									position++
+								}
+							l154:
+								{
+									position156, tokenIndex156, depth156 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l157
+									}
+									position++
+									goto l156
+								l157:
+									position, tokenIndex, depth = position156, tokenIndex156, depth156
+									if buffer[position] != rune('L') {
+										goto l150
+									}
+									position++
+								}
+							l156:
+								if !_rules[ruleKEY]() {
+									goto l150
+								}
+								{
+									add(ruleAction1, position)
+								}
+								depth--
+								add(ruledescribeAllStmt, position151)
+							}
+							goto l149
+						l150:
+							position, tokenIndex, depth = position149, tokenIndex149, depth149
+							{
+								position160 := position
+								depth++
+								if !_rules[rule_]() {
+									goto l159
+								}
+								{
+									position161, tokenIndex161, depth161 := position, tokenIndex, depth
+									if buffer[position] != rune('m') {
+										goto l162
+									}
+									position++
+									goto l161
+								l162:
+									position, tokenIndex, depth = position161, tokenIndex161, depth161
+									if buffer[position] != rune('M') {
+										goto l159
+									}
+									position++
+								}
+							l161:
+								{
+									position163, tokenIndex163, depth163 := position, tokenIndex, depth
+									if buffer[position] != rune('e') {
+										goto l164
+									}
+									position++
+									goto l163
+								l164:
+									position, tokenIndex, depth = position163, tokenIndex163, depth163
+									if buffer[position] != rune('E') {
+										goto l159
+									}
+									position++
+								}
+							l163:
+								{
+									position165, tokenIndex165, depth165 := position, tokenIndex, depth
+									if buffer[position] != rune('t') {
+										goto l166
+									}
+									position++
+									goto l165
+								l166:
+									position, tokenIndex, depth = position165, tokenIndex165, depth165
+									if buffer[position] != rune('T') {
+										goto l159
+									}
+									position++
+								}
+							l165:
+								{
+									position167, tokenIndex167, depth167 := position, tokenIndex, depth
+									if buffer[position] != rune('r') {
+										goto l168
+									}
+									position++
+									goto l167
+								l168:
+									position, tokenIndex, depth = position167, tokenIndex167, depth167
+									if buffer[position] != rune('R') {
+										goto l159
+									}
+									position++
+								}
+							l167:
+								{
+									position169, tokenIndex169, depth169 := position, tokenIndex, depth
+									if buffer[position] != rune('i') {
+										goto l170
+									}
+									position++
+									goto l169
+								l170:
+									position, tokenIndex, depth = position169, tokenIndex169, depth169
+									if buffer[position] != rune('I') {
+										goto l159
+									}
+									position++
+								}
+							l169:
+								{
+									position171, tokenIndex171, depth171 := position, tokenIndex, depth
+									if buffer[position] != rune('c') {
+										goto l172
+									}
+									position++
+									goto l171
+								l172:
+									position, tokenIndex, depth = position171, tokenIndex171, depth171
+									if buffer[position] != rune('C') {
+										goto l159
+									}
+									position++
+								}
+							l171:
+								{
+									position173, tokenIndex173, depth173 := position, tokenIndex, depth
+									if buffer[position] != rune('s') {
+										goto l174
+									}
+									position++
+									goto l173
+								l174:
+									position, tokenIndex, depth = position173, tokenIndex173, depth173
+									if buffer[position] != rune('S') {
+										goto l159
+									}
+									position++
+								}
+							l173:
+								if !_rules[ruleKEY]() {
+									goto l159
+								}
+								if !_rules[rulepredicateClause]() {
+									goto l159
+								}
+								{
+									add(ruleAction2, position)
+								}
+								depth--
+								add(ruledescribeMetrics, position160)
+							}
+							goto l149
+						l159:
+							position, tokenIndex, depth = position149, tokenIndex149, depth149
+							{
+								position177 := position
+								depth++
+								if !_rules[rule_]() {
+									goto l176
+								}
+								{
+									position178, tokenIndex178, depth178 := position, tokenIndex, depth
+									if buffer[position] != rune('t') {
+										goto l179
+									}
+									position++
+									goto l178
+								l179:
+									position, tokenIndex, depth = position178, tokenIndex178, depth178
+									if buffer[position] != rune('T') {
+										goto l176
+									}
+									position++
+								}
+							l178:
+								{
+									position180, tokenIndex180, depth180 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l181
+									}
+									position++
+									goto l180
+								l181:
+									position, tokenIndex, depth = position180, tokenIndex180, depth180
+									if buffer[position] != rune('A') {
+										goto l176
+									}
+									position++
+								}
+							l180:
+								{
+									position182, tokenIndex182, depth182 := position, tokenIndex, depth
+									if buffer[position] != rune('g') {
+										goto l183
+									}
+									position++
+									goto l182
+								l183:
+									position, tokenIndex, depth = position182, tokenIndex182, depth182
+									if buffer[position] != rune('G') {
+										goto l176
+									}
+									position++
+								}
+							l182:
+								{
+									position184, tokenIndex184, depth184 := position, tokenIndex, depth
+									if buffer[position] != rune('s') {
+										goto l185
+									}
+									position++
+									goto l184
+								l185:
+									position, tokenIndex, depth = position184, tokenIndex184, depth184
+									if buffer[position] != rune('S') {
+										goto l176
+									}
+									position++
+								}
+							l184:
+								if !_rules[ruleKEY]() {
+									goto l176
+								}
+								{
+									position186, tokenIndex186, depth186 := position, tokenIndex, depth
+									if !_rules[rule_]() {
+										goto l187
+									}
+									{
+										position188 := position
+										depth++
+										if !_rules[ruleMETRIC_NAME]() {
+											goto l187
+										}
+										depth--
+										add(rulePegText, position188)
+									}
+									{
+										add(ruleAction3, position)
+									}
+									if !_rules[ruleoptionalPredicateClause]() {
+										goto l187
+									}
+									goto l186
+								l187:
+									position, tokenIndex, depth = position186, tokenIndex186, depth186
+									{
+										add(ruleAction4, position)
+									}
+								}
+							l186:
+								{
+									add(ruleAction5, position)
+								}
+								depth--
+								add(ruledescribeTagsStmt, position177)
+							}
+							goto l149
+						l176:
+							position, tokenIndex, depth = position149, tokenIndex149, depth149
+							{
+								position193 := position
+								depth++
+								if !_rules[rule_]() {
+									goto l192
+								}
+								{
+									position194, tokenIndex194, depth194 := position, tokenIndex, depth
+									if buffer[position] != rune('v') {
+										goto l195
+									}
+									position++
+									goto l194
+								l195:
+									position, tokenIndex, depth = position194, tokenIndex194, depth194
+									if buffer[position] != rune('V') {
+										goto l192
+									}
+									position++
+								}
+							l194:
+								{
+									position196, tokenIndex196, depth196 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l197
+									}
+									position++
+									goto l196
+								l197:
+									position, tokenIndex, depth = position196, tokenIndex196, depth196
+									if buffer[position] != rune('A') {
+										goto l192
+									}
+									position++
+								}
+							l196:
+								{
+									position198, tokenIndex198, depth198 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l199
+									}
+									position++
+									goto l198
+								l199:
+									position, tokenIndex, depth = position198, tokenIndex198, depth198
+									if buffer[position] != rune('L') {
+										goto l192
+									}
+									position++
+								}
+							l198:
+								{
+									position200, tokenIndex200, depth200 := position, tokenIndex, depth
+									if buffer[position] != rune('u') {
+										goto l201
+									}
+									position++
+									goto l200
+								l201:
+									position, tokenIndex, depth = position200, tokenIndex200, depth200
+									if buffer[position] != rune('U') {
+										goto l192
+									}
+									position++
+								}
+							l200:
+								{
+									position202, tokenIndex202, depth202 := position, tokenIndex, depth
+									if buffer[position] != rune('e') {
+										goto l203
+									}
+									position++
+									goto l202
+								l203:
+									position, tokenIndex, depth = position202, tokenIndex202, depth202
+									if buffer[position] != rune('E') {
+										goto l192
+									}
+									position++
+								}
+							l202:
+								{
+									position204, tokenIndex204, depth204 := position, tokenIndex, depth
+									if buffer[position] != rune('s') {
+										goto l205
+									}
+									position++
+									goto l204
+								l205:
+									position, tokenIndex, depth = position204, tokenIndex204, depth204
+									if buffer[position] != rune('S') {
+										goto l192
+									}
+									position++
+								}
+							l204:
+								if !_rules[ruleKEY]() {
+									goto l192
+								}
+								if !_rules[ruletagName]() {
+									goto l192
+								}
+								{
+									position206, tokenIndex206, depth206 := position, tokenIndex, depth
+									if !_rules[rule_]() {
+										goto l207
+									}
+									{
+										position208, tokenIndex208, depth208 := position, tokenIndex, depth
+										if buffer[position] != rune('f') {
+											goto l209
+										}
+										position++
+										goto l208
+									l209:
+										position, tokenIndex, depth = position208, tokenIndex208, depth208
+										if buffer[position] != rune('F') {
+											goto l207
+										}
+										position++
+									}
+								l208:
+									{
+										position210, tokenIndex210, depth210 := position, tokenIndex, depth
+										if buffer[position] != rune('o') {
+											goto l211
+										}
+										position++
+										goto l210
+									l211:
+										position, tokenIndex, depth = position210, tokenIndex210, depth210
+										if buffer[position] != rune('O') {
+											goto l207
+										}
+										position++
+									}
+								l210:
+									{
+										position212, tokenIndex212, depth212 := position, tokenIndex, depth
+										if buffer[position] != rune('r') {
+											goto l213
+										}
+										position++
+										goto l212
+									l213:
+										position, tokenIndex, depth = position212, tokenIndex212, depth212
+										if buffer[position] != rune('R') {
+											goto l207
+										}
+										position++
+									}
+								l212:
+									if !_rules[ruleKEY]() {
+										goto l207
+									}
+									if !_rules[rule_]() {
+										goto l207
+									}
+									{
+										position214 := position
+										depth++
+										if !_rules[ruleMETRIC_NAME]() {
+											goto l207
+										}
+										depth--
+										add(rulePegText, position214)
+									}
+									{
+										add(ruleAction6, position)
+									}
+									goto l206
+								l207:
+									position, tokenIndex, depth = position206, tokenIndex206, depth206
+									{
+										add(ruleAction7, position)
+									}
+								}
+							l206:
+								if !_rules[ruleoptionalPredicateClause]() {
+									goto l192
+								}
+								{
+									add(ruleAction8, position)
+								}
+								depth--
+								add(ruledescribeValuesStmt, position193)
+							}
+							goto l149
+						l192:
+							position, tokenIndex, depth = position149, tokenIndex149, depth149
+							{
+								position219 := position
+								depth++
+								if !_rules[rule_]() {
+									goto l218
+								}
+								{
+									position220, tokenIndex220, depth220 := position, tokenIndex, depth
+									if buffer[position] != rune('c') {
+										goto l221
+									}
+									position++
+									goto l220
+								l221:
+									position, tokenIndex, depth = position220, tokenIndex220, depth220
+									if buffer[position] != rune('C') {
+										goto l218
+									}
+									position++
+								}
+							l220:
 								{
-									position86, tokenIndex86, depth86 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l87
+									position222, tokenIndex222, depth222 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l223
 									}
 									position++
-									goto l86
-								l87:
-									position, tokenIndex, depth = position86, tokenIndex86, depth86
-									if buffer[position] != rune('S') {
-										goto l72
+									goto l222
+								l223:
+									position, tokenIndex, depth = position222, tokenIndex222, depth222
+									if buffer[position] != rune('A') {
+										goto l218
 									}
 									position++
 								}
-							l86:
-								if !_rules[ruleKEY]() {
-									goto l72
-								}
-								if !_rules[rule_]() {
-									goto l72
+							l222:
+								{
+									position224, tokenIndex224, depth224 := position, tokenIndex, depth
+									if buffer[position] != rune('r') {
+										goto l225
+									}
+									position++
+									goto l224
+								l225:
+									position, tokenIndex, depth = position224, tokenIndex224, depth224
+									if buffer[position] != rune('R') {
+										goto l218
+									}
+									position++
 								}
+							l224:
 								{
-									position88, tokenIndex88, depth88 := position, tokenIndex, depth
-									if buffer[position] != rune('w') {
-										goto l89
+									position226, tokenIndex226, depth226 := position, tokenIndex, depth
+									if buffer[position] != rune('d') {
+										goto l227
 									}
 									position++
-									goto l88
-								l89:
-									position, tokenIndex, depth = position88, tokenIndex88, depth88
-									if buffer[position] != rune('W') {
-										goto l72
+									goto l226
+								l227:
+									position, tokenIndex, depth = position226, tokenIndex226, depth226
+									if buffer[position] != rune('D') {
+										goto l218
 									}
 									position++
 								}
-							l88:
+							l226:
 								{
-									position90, tokenIndex90, depth90 := position, tokenIndex, depth
-									if buffer[position] != rune('h') {
-										goto l91
+									position228, tokenIndex228, depth228 := position, tokenIndex, depth
+									if buffer[position] != rune('i') {
+										goto l229
 									}
 									position++
-									goto l90
-								l91:
-									position, tokenIndex, depth = position90, tokenIndex90, depth90
-									if buffer[position] != rune('H') {
-										goto l72
+									goto l228
+								l229:
+									position, tokenIndex, depth = position228, tokenIndex228, depth228
+									if buffer[position] != rune('I') {
+										goto l218
 									}
 									position++
 								}
-							l90:
+							l228:
 								{
-									position92, tokenIndex92, depth92 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l93
+									position230, tokenIndex230, depth230 := position, tokenIndex, depth
+									if buffer[position] != rune('n') {
+										goto l231
 									}
 									position++
-									goto l92
-								l93:
-									position, tokenIndex, depth = position92, tokenIndex92, depth92
-									if buffer[position] != rune('E') {
-										goto l72
+									goto l230
+								l231:
+									position, tokenIndex, depth = position230, tokenIndex230, depth230
+									if buffer[position] != rune('N') {
+										goto l218
 									}
 									position++
 								}
-							l92:
+							l230:
 								{
-									position94, tokenIndex94, depth94 := position, tokenIndex, depth
-									if buffer[position] != rune('r') {
-										goto l95
+									position232, tokenIndex232, depth232 := position, tokenIndex, depth
+									if buffer[position] != rune('a') {
+										goto l233
 									}
 									position++
-									goto l94
-								l95:
-									position, tokenIndex, depth = position94, tokenIndex94, depth94
-									if buffer[position] != rune('R') {
-										goto l72
+									goto l232
+								l233:
+									position, tokenIndex, depth = position232, tokenIndex232, depth232
+									if buffer[position] != rune('A') {
+										goto l218
 									}
 									position++
 								}
-							l94:
+							l232:
 								{
-									position96, tokenIndex96, depth96 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l97
+									position234, tokenIndex234, depth234 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l235
 									}
 									position++
-									goto l96
-								l97:
-									position, tokenIndex, depth = position96, tokenIndex96, depth96
-									if buffer[position] != rune('E') {
-										goto l72
+									goto l234
+								l235:
+									position, tokenIndex, depth = position234, tokenIndex234, depth234
+									if buffer[position] != rune('L') {
+										goto l218
 									}
 									position++
 								}
-							l96:
-								if !_rules[ruleKEY]() {
-									goto l72
+							l234:
+								{
+									position236, tokenIndex236, depth236 := position, tokenIndex, depth
+									if buffer[position] != rune('i') {
+										goto l237
+									}
+									position++
+									goto l236
+								l237:
+									position, tokenIndex, depth = position236, tokenIndex236, depth236
+									if buffer[position] != rune('I') {
+										goto l218
+									}
+									position++
 								}
-								if !_rules[ruletagName]() {
-									goto l72
+							l236:
+								{
+									position238, tokenIndex238, depth238 := position, tokenIndex, depth
+									if buffer[position] != rune('t') {
+										goto l239
+									}
+									position++
+									goto l238
+								l239:
+									position, tokenIndex, depth = position238, tokenIndex238, depth238
+									if buffer[position] != rune('T') {
+										goto l218
+									}
+									position++
 								}
-								if !_rules[rule_]() {
-									goto l72
+							l238:
+								{
+									position240, tokenIndex240, depth240 := position, tokenIndex, depth
+									if buffer[position] != rune('y') {
+										goto l241
+									}
+									position++
+									goto l240
+								l241:
+									position, tokenIndex, depth = position240, tokenIndex240, depth240
+									if buffer[position] != rune('Y') {
+										goto l218
+									}
+									position++
 								}
-								if buffer[position] != rune('=') {
-									goto l72
+							l240:
+								if !_rules[ruleKEY]() {
+									goto l218
 								}
-								position++
-								if !_rules[ruleliteralString]() {
-									goto l72
+								{
+									position242, tokenIndex242, depth242 := position, tokenIndex, depth
+									if !_rules[rule_]() {
+										goto l243
+									}
+									{
+										position244 := position
+										depth++
+										if !_rules[ruleMETRIC_NAME]() {
+											goto l243
+										}
+										depth--
+										add(rulePegText, position244)
+									}
+									{
+										add(ruleAction9, position)
+									}
+									goto l242
+								l243:
+									position, tokenIndex, depth = position242, tokenIndex242, depth242
+									{
+										add(ruleAction10, position)
+									}
 								}
+							l242:
 								{
-									add(ruleAction2, position)
+									add(ruleAction11, position)
 								}
 								depth--
-								add(ruledescribeMetrics, position73)
+								add(ruledescribeCardinalityStmt, position219)
 							}
-							goto l62
-						l72:
-							position, tokenIndex, depth = position62, tokenIndex62, depth62
+							goto l149
+						l218:
+							position, tokenIndex, depth = position149, tokenIndex149, depth149
 							{
-								position99 := position
+								position248 := position
 								depth++
 								if !_rules[rule_]() {
 									goto l0
 								}
 								{
-									position100 := position
+									position249 := position
 									depth++
-									{
-										position101 := position
-										depth++
-										if !_rules[ruleIDENTIFIER]() {
-											goto l0
-										}
-										depth--
-										add(ruleMETRIC_NAME, position101)
+									if !_rules[ruleMETRIC_NAME]() {
+										goto l0
 									}
 									depth--
-									add(rulePegText, position100)
+									add(rulePegText, position249)
 								}
 								{
-									add(ruleAction3, position)
+									add(ruleAction12, position)
 								}
 								if !_rules[ruleoptionalPredicateClause]() {
 									goto l0
 								}
 								{
-									add(ruleAction4, position)
+									add(ruleAction13, position)
 								}
 								depth--
-								add(ruledescribeSingleStmt, position99)
+								add(ruledescribeSingleStmt, position248)
 							}
 						}
-					l62:
+					l149:
 						depth--
-						add(ruledescribeStmt, position45)
+						add(ruledescribeStmt, position132)
 					}
 				}
 			l2:
@@ -1589,13 +2780,13 @@ func (p *Parser) Init() {
 					goto l0
 				}
 				{
-					position104, tokenIndex104, depth104 := position, tokenIndex, depth
+					position252, tokenIndex252, depth252 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l104
+						goto l252
 					}
 					goto l0
-				l104:
-					position, tokenIndex, depth = position104, tokenIndex104, depth104
+				l252:
+					position, tokenIndex, depth = position252, tokenIndex252, depth252
 				}
 				depth--
 				add(ruleroot, position1)
@@ -1605,2450 +2796,3064 @@ func (p *Parser) Init() {
 			position, tokenIndex, depth = position0, tokenIndex0, depth0
 			return false
 		},
-		/* 1 selectStmt <- <(_ (('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') KEY)? expressionList optionalPredicateClause propertyClause Action0)> */
+		/* 1 selectStmt <- <(_ (('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') KEY)? expressionList optionalPredicateClause propertyClause paginationClause Action0)> */
 		nil,
-		/* 2 describeStmt <- <(_ (('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E')) KEY (describeAllStmt / describeMetrics / describeSingleStmt))> */
+		/* 2 describeStmt <- <(_ (('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E')) KEY (describeAllStmt / describeMetrics / describeTagsStmt / describeValuesStmt / describeCardinalityStmt / describeSingleStmt))> */
 		nil,
 		/* 3 describeAllStmt <- <(_ (('a' / 'A') ('l' / 'L') ('l' / 'L')) KEY Action1)> */
 		nil,
-		/* 4 describeMetrics <- <(_ (('m' / 'M') ('e' / 'E') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('c' / 'C') ('s' / 'S')) KEY _ (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) KEY tagName _ '=' literalString Action2)> */
+		/* 4 describeMetrics <- <(_ (('m' / 'M') ('e' / 'E') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('c' / 'C') ('s' / 'S')) KEY predicateClause Action2)> */
+		nil,
+		/* 5 describeTagsStmt <- <(_ (('t' / 'T') ('a' / 'A') ('g' / 'G') ('s' / 'S')) KEY ((_ <METRIC_NAME> Action3 optionalPredicateClause) / Action4) Action5)> */
+		nil,
+		/* 6 describeValuesStmt <- <(_ (('v' / 'V') ('a' / 'A') ('l' / 'L') ('u' / 'U') ('e' / 'E') ('s' / 'S')) KEY tagName ((_ (('f' / 'F') ('o' / 'O') ('r' / 'R')) KEY _ <METRIC_NAME> Action6) / Action7) optionalPredicateClause Action8)> */
+		nil,
+		/* 7 describeCardinalityStmt <- <(_ (('c' / 'C') ('a' / 'A') ('r' / 'R') ('d' / 'D') ('i' / 'I') ('n' / 'N') ('a' / 'A') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('y' / 'Y')) KEY ((_ <METRIC_NAME> Action9) / Action10) Action11)> */
 		nil,
-		/* 5 describeSingleStmt <- <(_ <METRIC_NAME> Action3 optionalPredicateClause Action4)> */
+		/* 8 describeSingleStmt <- <(_ <METRIC_NAME> Action12 optionalPredicateClause Action13)> */
 		nil,
-		/* 6 propertyClause <- <(Action5 (_ PROPERTY_KEY Action6 _ PROPERTY_VALUE Action7 Action8)* Action9)> */
+		/* 9 propertyClause <- <(Action14 (_ PROPERTY_KEY Action15 _ PROPERTY_VALUE Action16 Action17)* Action18)> */
 		nil,
-		/* 7 optionalPredicateClause <- <(predicateClause / Action10)> */
+		/* 10 paginationClause <- <(Action19 (_ (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) KEY _ (('b' / 'B') ('y' / 'Y')) KEY ((_ (('s' / 'S') ('u' / 'U') ('m' / 'M') ('m' / 'M') ('a' / 'A') ('r' / 'R') ('y' / 'Y')) _ PAREN_OPEN _ <IDENTIFIER> _ PAREN_CLOSE Action20) / (_ <TAG_NAME> Action21)) ((_ (('a' / 'A') ('s' / 'S') ('c' / 'C')) KEY) / (_ (('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C')) KEY Action22))?)? (_ (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) KEY _ <NUMBER_NATURAL> Action23)? (_ (('o' / 'O') ('f' / 'F') ('f' / 'F') ('s' / 'S') ('e' / 'E') ('t' / 'T')) KEY _ <NUMBER_NATURAL> Action24)?)> */
+		nil,
+		/* 11 optionalPredicateClause <- <(predicateClause / Action25)> */
 		func() bool {
 			{
-				position112 := position
+				position264 := position
 				depth++
 				{
-					position113, tokenIndex113, depth113 := position, tokenIndex, depth
-					{
-						position115 := position
-						depth++
-						if !_rules[rule_]() {
-							goto l114
-						}
-						{
-							position116, tokenIndex116, depth116 := position, tokenIndex, depth
-							if buffer[position] != rune('w') {
-								goto l117
-							}
-							position++
-							goto l116
-						l117:
-							position, tokenIndex, depth = position116, tokenIndex116, depth116
-							if buffer[position] != rune('W') {
-								goto l114
-							}
-							position++
-						}
-					l116:
-						{
-							position118, tokenIndex118, depth118 := position, tokenIndex, depth
-							if buffer[position] != rune('h') {
-								goto l119
-							}
-							position++
-							goto l118
-						l119:
-							position, tokenIndex, depth = position118, tokenIndex118, depth118
-							if buffer[position] != rune('H') {
-								goto l114
-							}
-							position++
-						}
-					l118:
-						{
-							position120, tokenIndex120, depth120 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l121
-							}
-							position++
-							goto l120
-						l121:
-							position, tokenIndex, depth = position120, tokenIndex120, depth120
-							if buffer[position] != rune('E') {
-								goto l114
-							}
-							position++
-						}
-					l120:
-						{
-							position122, tokenIndex122, depth122 := position, tokenIndex, depth
-							if buffer[position] != rune('r') {
-								goto l123
-							}
-							position++
-							goto l122
-						l123:
-							position, tokenIndex, depth = position122, tokenIndex122, depth122
-							if buffer[position] != rune('R') {
-								goto l114
-							}
-							position++
-						}
-					l122:
-						{
-							position124, tokenIndex124, depth124 := position, tokenIndex, depth
-							if buffer[position] != rune('e') {
-								goto l125
-							}
-							position++
-							goto l124
-						l125:
-							position, tokenIndex, depth = position124, tokenIndex124, depth124
-							if buffer[position] != rune('E') {
-								goto l114
-							}
-							position++
-						}
-					l124:
-						if !_rules[ruleKEY]() {
-							goto l114
-						}
-						if !_rules[rule_]() {
-							goto l114
-						}
-						if !_rules[rulepredicate_1]() {
-							goto l114
-						}
-						depth--
-						add(rulepredicateClause, position115)
+					position265, tokenIndex265, depth265 := position, tokenIndex, depth
+					if !_rules[rulepredicateClause]() {
+						goto l266
 					}
-					goto l113
-				l114:
-					position, tokenIndex, depth = position113, tokenIndex113, depth113
+					goto l265
+				l266:
+					position, tokenIndex, depth = position265, tokenIndex265, depth265
 					{
-						add(ruleAction10, position)
+						add(ruleAction25, position)
 					}
 				}
-			l113:
+			l265:
 				depth--
-				add(ruleoptionalPredicateClause, position112)
+				add(ruleoptionalPredicateClause, position264)
 			}
 			return true
 		},
-		/* 8 expressionList <- <(Action11 expression_start Action12 (_ COMMA expression_start Action13)*)> */
+		/* 12 expressionList <- <(Action26 expression_start Action27 (_ COMMA expression_start Action28)*)> */
 		func() bool {
-			position127, tokenIndex127, depth127 := position, tokenIndex, depth
+			position268, tokenIndex268, depth268 := position, tokenIndex, depth
 			{
-				position128 := position
+				position269 := position
 				depth++
 				{
-					add(ruleAction11, position)
+					add(ruleAction26, position)
 				}
 				if !_rules[ruleexpression_start]() {
-					goto l127
+					goto l268
 				}
 				{
-					add(ruleAction12, position)
+					add(ruleAction27, position)
 				}
-			l131:
+			l272:
 				{
-					position132, tokenIndex132, depth132 := position, tokenIndex, depth
+					position273, tokenIndex273, depth273 := position, tokenIndex, depth
 					if !_rules[rule_]() {
-						goto l132
+						goto l273
 					}
 					if !_rules[ruleCOMMA]() {
-						goto l132
+						goto l273
 					}
 					if !_rules[ruleexpression_start]() {
-						goto l132
+						goto l273
 					}
 					{
-						add(ruleAction13, position)
+						add(ruleAction28, position)
 					}
-					goto l131
-				l132:
-					position, tokenIndex, depth = position132, tokenIndex132, depth132
+					goto l272
+				l273:
+					position, tokenIndex, depth = position273, tokenIndex273, depth273
 				}
 				depth--
-				add(ruleexpressionList, position128)
+				add(ruleexpressionList, position269)
 			}
 			return true
-		l127:
-			position, tokenIndex, depth = position127, tokenIndex127, depth127
+		l268:
+			position, tokenIndex, depth = position268, tokenIndex268, depth268
 			return false
 		},
-		/* 9 expression_start <- <(expression_sum add_pipe)> */
+		/* 13 expression_start <- <(expression_comparison add_pipe)> */
 		func() bool {
-			position134, tokenIndex134, depth134 := position, tokenIndex, depth
+			position275, tokenIndex275, depth275 := position, tokenIndex, depth
 			{
-				position135 := position
+				position276 := position
 				depth++
 				{
-					position136 := position
+					position277 := position
 					depth++
-					if !_rules[ruleexpression_product]() {
-						goto l134
+					if !_rules[ruleexpression_sum]() {
+						goto l275
 					}
-				l137:
 					{
-						position138, tokenIndex138, depth138 := position, tokenIndex, depth
+						position278, tokenIndex278, depth278 := position, tokenIndex, depth
 						if !_rules[ruleadd_pipe]() {
-							goto l138
+							goto l278
 						}
 						{
-							position139, tokenIndex139, depth139 := position, tokenIndex, depth
+							position280, tokenIndex280, depth280 := position, tokenIndex, depth
 							if !_rules[rule_]() {
-								goto l140
+								goto l281
 							}
 							{
-								position141 := position
+								position282 := position
 								depth++
-								if buffer[position] != rune('+') {
-									goto l140
+								if buffer[position] != rune('>') {
+									goto l281
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l281
 								}
 								position++
 								depth--
-								add(ruleOP_ADD, position141)
+								add(ruleOP_GE, position282)
 							}
 							{
-								add(ruleAction14, position)
+								add(ruleAction29, position)
 							}
-							goto l139
-						l140:
-							position, tokenIndex, depth = position139, tokenIndex139, depth139
+							goto l280
+						l281:
+							position, tokenIndex, depth = position280, tokenIndex280, depth280
 							if !_rules[rule_]() {
-								goto l138
+								goto l284
 							}
 							{
-								position143 := position
+								position285 := position
 								depth++
-								if buffer[position] != rune('-') {
-									goto l138
+								if buffer[position] != rune('<') {
+									goto l284
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l284
+								}
+								position++
+								depth--
+								add(ruleOP_LE, position285)
+							}
+							{
+								add(ruleAction30, position)
+							}
+							goto l280
+						l284:
+							position, tokenIndex, depth = position280, tokenIndex280, depth280
+							if !_rules[rule_]() {
+								goto l287
+							}
+							{
+								position288 := position
+								depth++
+								if buffer[position] != rune('=') {
+									goto l287
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l287
+								}
+								position++
+								depth--
+								add(ruleOP_EQ, position288)
+							}
+							{
+								add(ruleAction31, position)
+							}
+							goto l280
+						l287:
+							position, tokenIndex, depth = position280, tokenIndex280, depth280
+							if !_rules[rule_]() {
+								goto l290
+							}
+							{
+								position291 := position
+								depth++
+								if buffer[position] != rune('!') {
+									goto l290
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l290
+								}
+								position++
+								depth--
+								add(ruleOP_NE, position291)
+							}
+							{
+								add(ruleAction32, position)
+							}
+							goto l280
+						l290:
+							position, tokenIndex, depth = position280, tokenIndex280, depth280
+							if !_rules[rule_]() {
+								goto l293
+							}
+							{
+								position294 := position
+								depth++
+								if buffer[position] != rune('>') {
+									goto l293
+								}
+								position++
+								depth--
+								add(ruleOP_GT, position294)
+							}
+							{
+								add(ruleAction33, position)
+							}
+							goto l280
+						l293:
+							position, tokenIndex, depth = position280, tokenIndex280, depth280
+							if !_rules[rule_]() {
+								goto l278
+							}
+							{
+								position296 := position
+								depth++
+								if buffer[position] != rune('<') {
+									goto l278
 								}
 								position++
 								depth--
-								add(ruleOP_SUB, position143)
+								add(ruleOP_LT, position296)
+							}
+							{
+								add(ruleAction34, position)
+							}
+						}
+					l280:
+						{
+							position298, tokenIndex298, depth298 := position, tokenIndex, depth
+							if !_rules[rule_]() {
+								goto l298
+							}
+							{
+								position300 := position
+								depth++
+								{
+									position301, tokenIndex301, depth301 := position, tokenIndex, depth
+									if buffer[position] != rune('b') {
+										goto l302
+									}
+									position++
+									goto l301
+								l302:
+									position, tokenIndex, depth = position301, tokenIndex301, depth301
+									if buffer[position] != rune('B') {
+										goto l298
+									}
+									position++
+								}
+							l301:
+								{
+									position303, tokenIndex303, depth303 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l304
+									}
+									position++
+									goto l303
+								l304:
+									position, tokenIndex, depth = position303, tokenIndex303, depth303
+									if buffer[position] != rune('O') {
+										goto l298
+									}
+									position++
+								}
+							l303:
+								{
+									position305, tokenIndex305, depth305 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l306
+									}
+									position++
+									goto l305
+								l306:
+									position, tokenIndex, depth = position305, tokenIndex305, depth305
+									if buffer[position] != rune('O') {
+										goto l298
+									}
+									position++
+								}
+							l305:
+								{
+									position307, tokenIndex307, depth307 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l308
+									}
+									position++
+									goto l307
+								l308:
+									position, tokenIndex, depth = position307, tokenIndex307, depth307
+									if buffer[position] != rune('L') {
+										goto l298
+									}
+									position++
+								}
+							l307:
+								if !_rules[ruleKEY]() {
+									goto l298
+								}
+								depth--
+								add(ruleOP_BOOL, position300)
 							}
 							{
-								add(ruleAction15, position)
+								add(ruleAction35, position)
+							}
+							goto l299
+						l298:
+							position, tokenIndex, depth = position298, tokenIndex298, depth298
+						}
+					l299:
+						if !_rules[ruleexpression_sum]() {
+							goto l278
+						}
+						{
+							add(ruleAction36, position)
+						}
+						goto l279
+					l278:
+						position, tokenIndex, depth = position278, tokenIndex278, depth278
+					}
+				l279:
+					depth--
+					add(ruleexpression_comparison, position277)
+				}
+				if !_rules[ruleadd_pipe]() {
+					goto l275
+				}
+				depth--
+				add(ruleexpression_start, position276)
+			}
+			return true
+		l275:
+			position, tokenIndex, depth = position275, tokenIndex275, depth275
+			return false
+		},
+		/* 14 expression_comparison <- <(expression_sum (add_pipe ((_ OP_GE Action29) / (_ OP_LE Action30) / (_ OP_EQ Action31) / (_ OP_NE Action32) / (_ OP_GT Action33) / (_ OP_LT Action34)) (_ OP_BOOL Action35)? expression_sum Action36)?)> */
+		nil,
+		/* 15 expression_sum <- <(expression_product (add_pipe ((_ OP_ADD Action37) / (_ OP_SUB Action38)) expression_product Action39)*)> */
+		func() bool {
+			position312, tokenIndex312, depth312 := position, tokenIndex, depth
+			{
+				position313 := position
+				depth++
+				if !_rules[ruleexpression_product]() {
+					goto l312
+				}
+			l314:
+				{
+					position315, tokenIndex315, depth315 := position, tokenIndex, depth
+					if !_rules[ruleadd_pipe]() {
+						goto l315
+					}
+					{
+						position316, tokenIndex316, depth316 := position, tokenIndex, depth
+						if !_rules[rule_]() {
+							goto l317
+						}
+						{
+							position318 := position
+							depth++
+							if buffer[position] != rune('+') {
+								goto l317
 							}
+							position++
+							depth--
+							add(ruleOP_ADD, position318)
 						}
-					l139:
-						if !_rules[ruleexpression_product]() {
-							goto l138
+						{
+							add(ruleAction37, position)
+						}
+						goto l316
+					l317:
+						position, tokenIndex, depth = position316, tokenIndex316, depth316
+						if !_rules[rule_]() {
+							goto l315
+						}
+						{
+							position320 := position
+							depth++
+							if buffer[position] != rune('-') {
+								goto l315
+							}
+							position++
+							depth--
+							add(ruleOP_SUB, position320)
 						}
 						{
-							add(ruleAction16, position)
+							add(ruleAction38, position)
 						}
-						goto l137
-					l138:
-						position, tokenIndex, depth = position138, tokenIndex138, depth138
 					}
-					depth--
-					add(ruleexpression_sum, position136)
-				}
-				if !_rules[ruleadd_pipe]() {
-					goto l134
+				l316:
+					if !_rules[ruleexpression_product]() {
+						goto l315
+					}
+					{
+						add(ruleAction39, position)
+					}
+					goto l314
+				l315:
+					position, tokenIndex, depth = position315, tokenIndex315, depth315
 				}
 				depth--
-				add(ruleexpression_start, position135)
+				add(ruleexpression_sum, position313)
 			}
 			return true
-		l134:
-			position, tokenIndex, depth = position134, tokenIndex134, depth134
+		l312:
+			position, tokenIndex, depth = position312, tokenIndex312, depth312
 			return false
 		},
-		/* 10 expression_sum <- <(expression_product (add_pipe ((_ OP_ADD Action14) / (_ OP_SUB Action15)) expression_product Action16)*)> */
-		nil,
-		/* 11 expression_product <- <(expression_atom (add_pipe ((_ OP_DIV Action17) / (_ OP_MULT Action18)) expression_atom Action19)*)> */
+		/* 16 expression_product <- <(expression_atom (add_pipe ((_ OP_DIV Action40) / (_ OP_MULT Action41)) expression_atom Action42)*)> */
 		func() bool {
-			position147, tokenIndex147, depth147 := position, tokenIndex, depth
+			position323, tokenIndex323, depth323 := position, tokenIndex, depth
 			{
-				position148 := position
+				position324 := position
 				depth++
 				if !_rules[ruleexpression_atom]() {
-					goto l147
+					goto l323
 				}
-			l149:
+			l325:
 				{
-					position150, tokenIndex150, depth150 := position, tokenIndex, depth
+					position326, tokenIndex326, depth326 := position, tokenIndex, depth
 					if !_rules[ruleadd_pipe]() {
-						goto l150
+						goto l326
 					}
 					{
-						position151, tokenIndex151, depth151 := position, tokenIndex, depth
+						position327, tokenIndex327, depth327 := position, tokenIndex, depth
 						if !_rules[rule_]() {
-							goto l152
+							goto l328
 						}
 						{
-							position153 := position
+							position329 := position
 							depth++
 							if buffer[position] != rune('/') {
-								goto l152
+								goto l328
 							}
 							position++
 							depth--
-							add(ruleOP_DIV, position153)
+							add(ruleOP_DIV, position329)
 						}
 						{
-							add(ruleAction17, position)
+							add(ruleAction40, position)
 						}
-						goto l151
-					l152:
-						position, tokenIndex, depth = position151, tokenIndex151, depth151
+						goto l327
+					l328:
+						position, tokenIndex, depth = position327, tokenIndex327, depth327
 						if !_rules[rule_]() {
-							goto l150
+							goto l326
 						}
 						{
-							position155 := position
+							position331 := position
 							depth++
 							if buffer[position] != rune('*') {
-								goto l150
+								goto l326
 							}
 							position++
 							depth--
-							add(ruleOP_MULT, position155)
+							add(ruleOP_MULT, position331)
 						}
 						{
-							add(ruleAction18, position)
+							add(ruleAction41, position)
 						}
 					}
-				l151:
+				l327:
 					if !_rules[ruleexpression_atom]() {
-						goto l150
+						goto l326
 					}
 					{
-						add(ruleAction19, position)
+						add(ruleAction42, position)
 					}
-					goto l149
-				l150:
-					position, tokenIndex, depth = position150, tokenIndex150, depth150
+					goto l325
+				l326:
+					position, tokenIndex, depth = position326, tokenIndex326, depth326
 				}
 				depth--
-				add(ruleexpression_product, position148)
+				add(ruleexpression_product, position324)
 			}
 			return true
-		l147:
-			position, tokenIndex, depth = position147, tokenIndex147, depth147
+		l323:
+			position, tokenIndex, depth = position323, tokenIndex323, depth323
 			return false
 		},
-		/* 12 add_pipe <- <(_ OP_PIPE _ <IDENTIFIER> Action20 ((_ PAREN_OPEN (expressionList / Action21) Action22 groupByClause? _ PAREN_CLOSE) / Action23) Action24)*> */
+		/* 17 add_pipe <- <(_ OP_PIPE _ <IDENTIFIER> Action43 ((_ PAREN_OPEN (expressionList / Action44) Action45 groupByClause? _ PAREN_CLOSE) / Action46) Action47)*> */
 		func() bool {
 			{
-				position159 := position
+				position335 := position
 				depth++
-			l160:
+			l336:
 				{
-					position161, tokenIndex161, depth161 := position, tokenIndex, depth
+					position337, tokenIndex337, depth337 := position, tokenIndex, depth
 					if !_rules[rule_]() {
-						goto l161
+						goto l337
 					}
 					{
-						position162 := position
+						position338 := position
 						depth++
 						if buffer[position] != rune('|') {
-							goto l161
+							goto l337
 						}
 						position++
 						depth--
-						add(ruleOP_PIPE, position162)
+						add(ruleOP_PIPE, position338)
 					}
 					if !_rules[rule_]() {
-						goto l161
+						goto l337
 					}
 					{
-						position163 := position
+						position339 := position
 						depth++
 						if !_rules[ruleIDENTIFIER]() {
-							goto l161
+							goto l337
 						}
 						depth--
-						add(rulePegText, position163)
+						add(rulePegText, position339)
 					}
 					{
-						add(ruleAction20, position)
+						add(ruleAction43, position)
 					}
 					{
-						position165, tokenIndex165, depth165 := position, tokenIndex, depth
+						position341, tokenIndex341, depth341 := position, tokenIndex, depth
 						if !_rules[rule_]() {
-							goto l166
+							goto l342
 						}
 						if !_rules[rulePAREN_OPEN]() {
-							goto l166
+							goto l342
 						}
 						{
-							position167, tokenIndex167, depth167 := position, tokenIndex, depth
+							position343, tokenIndex343, depth343 := position, tokenIndex, depth
 							if !_rules[ruleexpressionList]() {
-								goto l168
+								goto l344
 							}
-							goto l167
-						l168:
-							position, tokenIndex, depth = position167, tokenIndex167, depth167
+							goto l343
+						l344:
+							position, tokenIndex, depth = position343, tokenIndex343, depth343
 							{
-								add(ruleAction21, position)
+								add(ruleAction44, position)
 							}
 						}
-					l167:
+					l343:
 						{
-							add(ruleAction22, position)
+							add(ruleAction45, position)
 						}
 						{
-							position171, tokenIndex171, depth171 := position, tokenIndex, depth
+							position347, tokenIndex347, depth347 := position, tokenIndex, depth
 							if !_rules[rulegroupByClause]() {
-								goto l171
+								goto l347
 							}
-							goto l172
-						l171:
-							position, tokenIndex, depth = position171, tokenIndex171, depth171
+							goto l348
+						l347:
+							position, tokenIndex, depth = position347, tokenIndex347, depth347
 						}
-					l172:
+					l348:
 						if !_rules[rule_]() {
-							goto l166
+							goto l342
 						}
 						if !_rules[rulePAREN_CLOSE]() {
-							goto l166
+							goto l342
 						}
-						goto l165
-					l166:
-						position, tokenIndex, depth = position165, tokenIndex165, depth165
+						goto l341
+					l342:
+						position, tokenIndex, depth = position341, tokenIndex341, depth341
 						{
-							add(ruleAction23, position)
+							add(ruleAction46, position)
 						}
 					}
-				l165:
+				l341:
 					{
-						add(ruleAction24, position)
+						add(ruleAction47, position)
 					}
-					goto l160
-				l161:
-					position, tokenIndex, depth = position161, tokenIndex161, depth161
+					goto l336
+				l337:
+					position, tokenIndex, depth = position337, tokenIndex337, depth337
 				}
 				depth--
-				add(ruleadd_pipe, position159)
+				add(ruleadd_pipe, position335)
 			}
 			return true
 		},
-		/* 13 expression_atom <- <(expression_function / expression_metric / (_ PAREN_OPEN expression_start _ PAREN_CLOSE) / (_ <DURATION> Action25) / (_ <NUMBER> Action26) / (_ STRING Action27))> */
+		/* 18 expression_atom <- <(expression_function / expression_metric_pattern / expression_metric / (_ PAREN_OPEN expression_start _ PAREN_CLOSE) / (_ <DURATION> Action48) / (_ <NUMBER> Action49) / (_ STRING Action50))> */
 		func() bool {
-			position175, tokenIndex175, depth175 := position, tokenIndex, depth
+			position351, tokenIndex351, depth351 := position, tokenIndex, depth
 			{
-				position176 := position
+				position352 := position
 				depth++
 				{
-					position177, tokenIndex177, depth177 := position, tokenIndex, depth
+					position353, tokenIndex353, depth353 := position, tokenIndex, depth
 					{
-						position179 := position
+						position355 := position
 						depth++
 						if !_rules[rule_]() {
-							goto l178
+							goto l354
 						}
 						{
-							position180 := position
+							position356 := position
 							depth++
 							if !_rules[ruleIDENTIFIER]() {
-								goto l178
+								goto l354
 							}
 							depth--
-							add(rulePegText, position180)
+							add(rulePegText, position356)
 						}
 						{
-							add(ruleAction28, position)
+							add(ruleAction51, position)
 						}
 						if !_rules[rule_]() {
-							goto l178
+							goto l354
 						}
 						if !_rules[rulePAREN_OPEN]() {
-							goto l178
+							goto l354
 						}
 						if !_rules[ruleexpressionList]() {
-							goto l178
+							goto l354
 						}
 						{
-							add(ruleAction29, position)
+							add(ruleAction52, position)
 						}
 						{
-							position183, tokenIndex183, depth183 := position, tokenIndex, depth
+							position359, tokenIndex359, depth359 := position, tokenIndex, depth
 							if !_rules[rulegroupByClause]() {
-								goto l183
+								goto l359
 							}
-							goto l184
-						l183:
-							position, tokenIndex, depth = position183, tokenIndex183, depth183
+							goto l360
+						l359:
+							position, tokenIndex, depth = position359, tokenIndex359, depth359
 						}
-					l184:
+					l360:
 						if !_rules[rule_]() {
-							goto l178
+							goto l354
 						}
 						if !_rules[rulePAREN_CLOSE]() {
-							goto l178
+							goto l354
+						}
+						{
+							add(ruleAction53, position)
+						}
+						depth--
+						add(ruleexpression_function, position355)
+					}
+					goto l353
+				l354:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
+					{
+						position363 := position
+						depth++
+						if !_rules[rule_]() {
+							goto l362
+						}
+						{
+							position364, tokenIndex364, depth364 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l365
+							}
+							position++
+							goto l364
+						l365:
+							position, tokenIndex, depth = position364, tokenIndex364, depth364
+							if buffer[position] != rune('M') {
+								goto l362
+							}
+							position++
+						}
+					l364:
+						{
+							position366, tokenIndex366, depth366 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l367
+							}
+							position++
+							goto l366
+						l367:
+							position, tokenIndex, depth = position366, tokenIndex366, depth366
+							if buffer[position] != rune('E') {
+								goto l362
+							}
+							position++
+						}
+					l366:
+						{
+							position368, tokenIndex368, depth368 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l369
+							}
+							position++
+							goto l368
+						l369:
+							position, tokenIndex, depth = position368, tokenIndex368, depth368
+							if buffer[position] != rune('T') {
+								goto l362
+							}
+							position++
+						}
+					l368:
+						{
+							position370, tokenIndex370, depth370 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l371
+							}
+							position++
+							goto l370
+						l371:
+							position, tokenIndex, depth = position370, tokenIndex370, depth370
+							if buffer[position] != rune('R') {
+								goto l362
+							}
+							position++
+						}
+					l370:
+						{
+							position372, tokenIndex372, depth372 := position, tokenIndex, depth
+							if buffer[position] != rune('i') {
+								goto l373
+							}
+							position++
+							goto l372
+						l373:
+							position, tokenIndex, depth = position372, tokenIndex372, depth372
+							if buffer[position] != rune('I') {
+								goto l362
+							}
+							position++
+						}
+					l372:
+						{
+							position374, tokenIndex374, depth374 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l375
+							}
+							position++
+							goto l374
+						l375:
+							position, tokenIndex, depth = position374, tokenIndex374, depth374
+							if buffer[position] != rune('C') {
+								goto l362
+							}
+							position++
+						}
+					l374:
+						if !_rules[ruleKEY]() {
+							goto l362
+						}
+						if !_rules[rule_]() {
+							goto l362
+						}
+						{
+							position376, tokenIndex376, depth376 := position, tokenIndex, depth
+							if buffer[position] != rune('m') {
+								goto l377
+							}
+							position++
+							goto l376
+						l377:
+							position, tokenIndex, depth = position376, tokenIndex376, depth376
+							if buffer[position] != rune('M') {
+								goto l362
+							}
+							position++
+						}
+					l376:
+						{
+							position378, tokenIndex378, depth378 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l379
+							}
+							position++
+							goto l378
+						l379:
+							position, tokenIndex, depth = position378, tokenIndex378, depth378
+							if buffer[position] != rune('A') {
+								goto l362
+							}
+							position++
+						}
+					l378:
+						{
+							position380, tokenIndex380, depth380 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l381
+							}
+							position++
+							goto l380
+						l381:
+							position, tokenIndex, depth = position380, tokenIndex380, depth380
+							if buffer[position] != rune('T') {
+								goto l362
+							}
+							position++
+						}
+					l380:
+						{
+							position382, tokenIndex382, depth382 := position, tokenIndex, depth
+							if buffer[position] != rune('c') {
+								goto l383
+							}
+							position++
+							goto l382
+						l383:
+							position, tokenIndex, depth = position382, tokenIndex382, depth382
+							if buffer[position] != rune('C') {
+								goto l362
+							}
+							position++
 						}
+					l382:
 						{
-							add(ruleAction30, position)
+							position384, tokenIndex384, depth384 := position, tokenIndex, depth
+							if buffer[position] != rune('h') {
+								goto l385
+							}
+							position++
+							goto l384
+						l385:
+							position, tokenIndex, depth = position384, tokenIndex384, depth384
+							if buffer[position] != rune('H') {
+								goto l362
+							}
+							position++
+						}
+					l384:
+						{
+							position386, tokenIndex386, depth386 := position, tokenIndex, depth
+							if buffer[position] != rune('e') {
+								goto l387
+							}
+							position++
+							goto l386
+						l387:
+							position, tokenIndex, depth = position386, tokenIndex386, depth386
+							if buffer[position] != rune('E') {
+								goto l362
+							}
+							position++
+						}
+					l386:
+						{
+							position388, tokenIndex388, depth388 := position, tokenIndex, depth
+							if buffer[position] != rune('s') {
+								goto l389
+							}
+							position++
+							goto l388
+						l389:
+							position, tokenIndex, depth = position388, tokenIndex388, depth388
+							if buffer[position] != rune('S') {
+								goto l362
+							}
+							position++
+						}
+					l388:
+						if !_rules[ruleKEY]() {
+							goto l362
+						}
+						if !_rules[rule_]() {
+							goto l362
+						}
+						if !_rules[ruleSTRING]() {
+							goto l362
+						}
+						{
+							add(ruleAction57, position)
+						}
+						{
+							position391, tokenIndex391, depth391 := position, tokenIndex, depth
+							{
+								position393, tokenIndex393, depth393 := position, tokenIndex, depth
+								if !_rules[rule_]() {
+									goto l394
+								}
+								if buffer[position] != rune('[') {
+									goto l394
+								}
+								position++
+								if !_rules[rulepredicate_1]() {
+									goto l394
+								}
+								if !_rules[rule_]() {
+									goto l394
+								}
+								if buffer[position] != rune(']') {
+									goto l394
+								}
+								position++
+								goto l393
+							l394:
+								position, tokenIndex, depth = position393, tokenIndex393, depth393
+								{
+									add(ruleAction58, position)
+								}
+							}
+						l393:
+							goto l392
+
+							position, tokenIndex, depth = position391, tokenIndex391, depth391
+						}
+					l392:
+						{
+							add(ruleAction59, position)
 						}
 						depth--
-						add(ruleexpression_function, position179)
+						add(ruleexpression_metric_pattern, position363)
 					}
-					goto l177
-				l178:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l353
+				l362:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					{
-						position187 := position
+						position398 := position
 						depth++
 						if !_rules[rule_]() {
-							goto l186
+							goto l397
 						}
 						{
-							position188 := position
+							position399 := position
 							depth++
 							if !_rules[ruleIDENTIFIER]() {
-								goto l186
+								goto l397
 							}
 							depth--
-							add(rulePegText, position188)
+							add(rulePegText, position399)
 						}
 						{
-							add(ruleAction31, position)
+							add(ruleAction54, position)
 						}
 						{
-							position190, tokenIndex190, depth190 := position, tokenIndex, depth
+							position401, tokenIndex401, depth401 := position, tokenIndex, depth
 							{
-								position192, tokenIndex192, depth192 := position, tokenIndex, depth
+								position403, tokenIndex403, depth403 := position, tokenIndex, depth
 								if !_rules[rule_]() {
-									goto l193
+									goto l404
 								}
 								if buffer[position] != rune('[') {
-									goto l193
+									goto l404
 								}
 								position++
 								if !_rules[rulepredicate_1]() {
-									goto l193
+									goto l404
 								}
 								if !_rules[rule_]() {
-									goto l193
+									goto l404
 								}
 								if buffer[position] != rune(']') {
-									goto l193
+									goto l404
 								}
 								position++
-								goto l192
-							l193:
-								position, tokenIndex, depth = position192, tokenIndex192, depth192
+								goto l403
+							l404:
+								position, tokenIndex, depth = position403, tokenIndex403, depth403
 								{
-									add(ruleAction32, position)
+									add(ruleAction55, position)
 								}
 							}
-						l192:
-							goto l191
+						l403:
+							goto l402
 
-							position, tokenIndex, depth = position190, tokenIndex190, depth190
+							position, tokenIndex, depth = position401, tokenIndex401, depth401
 						}
-					l191:
+					l402:
 						{
-							add(ruleAction33, position)
+							add(ruleAction56, position)
 						}
 						depth--
-						add(ruleexpression_metric, position187)
+						add(ruleexpression_metric, position398)
 					}
-					goto l177
-				l186:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l353
+				l397:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					if !_rules[rule_]() {
-						goto l196
+						goto l407
 					}
 					if !_rules[rulePAREN_OPEN]() {
-						goto l196
+						goto l407
 					}
 					if !_rules[ruleexpression_start]() {
-						goto l196
+						goto l407
 					}
 					if !_rules[rule_]() {
-						goto l196
+						goto l407
 					}
 					if !_rules[rulePAREN_CLOSE]() {
-						goto l196
+						goto l407
 					}
-					goto l177
-				l196:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l353
+				l407:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					if !_rules[rule_]() {
-						goto l197
+						goto l408
 					}
 					{
-						position198 := position
+						position409 := position
 						depth++
 						{
-							position199 := position
+							position410 := position
 							depth++
 							if !_rules[ruleNUMBER]() {
-								goto l197
+								goto l408
 							}
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l197
+								goto l408
 							}
 							position++
-						l200:
+						l411:
 							{
-								position201, tokenIndex201, depth201 := position, tokenIndex, depth
+								position412, tokenIndex412, depth412 := position, tokenIndex, depth
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l201
+									goto l412
 								}
 								position++
-								goto l200
-							l201:
-								position, tokenIndex, depth = position201, tokenIndex201, depth201
+								goto l411
+							l412:
+								position, tokenIndex, depth = position412, tokenIndex412, depth412
 							}
 							depth--
-							add(ruleDURATION, position199)
+							add(ruleDURATION, position410)
 						}
 						depth--
-						add(rulePegText, position198)
+						add(rulePegText, position409)
 					}
 					{
-						add(ruleAction25, position)
+						add(ruleAction48, position)
 					}
-					goto l177
-				l197:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l353
+				l408:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					if !_rules[rule_]() {
-						goto l203
+						goto l414
 					}
 					{
-						position204 := position
+						position415 := position
 						depth++
 						if !_rules[ruleNUMBER]() {
-							goto l203
+							goto l414
 						}
 						depth--
-						add(rulePegText, position204)
+						add(rulePegText, position415)
 					}
 					{
-						add(ruleAction26, position)
+						add(ruleAction49, position)
 					}
-					goto l177
-				l203:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l353
+				l414:
+					position, tokenIndex, depth = position353, tokenIndex353, depth353
 					if !_rules[rule_]() {
-						goto l175
+						goto l351
 					}
 					if !_rules[ruleSTRING]() {
-						goto l175
+						goto l351
 					}
 					{
-						add(ruleAction27, position)
+						add(ruleAction50, position)
 					}
 				}
-			l177:
+			l353:
 				depth--
-				add(ruleexpression_atom, position176)
+				add(ruleexpression_atom, position352)
 			}
 			return true
-		l175:
-			position, tokenIndex, depth = position175, tokenIndex175, depth175
+		l351:
+			position, tokenIndex, depth = position351, tokenIndex351, depth351
 			return false
 		},
-		/* 14 expression_function <- <(_ <IDENTIFIER> Action28 _ PAREN_OPEN expressionList Action29 groupByClause? _ PAREN_CLOSE Action30)> */
+		/* 19 expression_function <- <(_ <IDENTIFIER> Action51 _ PAREN_OPEN expressionList Action52 groupByClause? _ PAREN_CLOSE Action53)> */
 		nil,
-		/* 15 expression_metric <- <(_ <IDENTIFIER> Action31 ((_ '[' predicate_1 _ ']') / Action32)? Action33)> */
+		/* 20 expression_metric <- <(_ <IDENTIFIER> Action54 ((_ '[' predicate_1 _ ']') / Action55)? Action56)> */
 		nil,
-		/* 16 groupByClause <- <(_ (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) KEY _ (('b' / 'B') ('y' / 'Y')) KEY _ <COLUMN_NAME> Action34 (_ COMMA _ <COLUMN_NAME> Action35)*)> */
+		/* 21 expression_metric_pattern <- <(_ (('m' / 'M') ('e' / 'E') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('c' / 'C')) KEY _ (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) KEY _ STRING Action57 ((_ '[' predicate_1 _ ']') / Action58)? Action59)> */
+		nil,
+		/* 22 groupByClause <- <(_ (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) KEY _ (('b' / 'B') ('y' / 'Y')) KEY _ <COLUMN_NAME> Action60 (_ COMMA _ <COLUMN_NAME> Action61)*)> */
 		func() bool {
-			position209, tokenIndex209, depth209 := position, tokenIndex, depth
+			position421, tokenIndex421, depth421 := position, tokenIndex, depth
 			{
-				position210 := position
+				position422 := position
 				depth++
 				if !_rules[rule_]() {
-					goto l209
+					goto l421
 				}
 				{
-					position211, tokenIndex211, depth211 := position, tokenIndex, depth
+					position423, tokenIndex423, depth423 := position, tokenIndex, depth
 					if buffer[position] != rune('g') {
-						goto l212
+						goto l424
 					}
 					position++
-					goto l211
-				l212:
-					position, tokenIndex, depth = position211, tokenIndex211, depth211
+					goto l423
+				l424:
+					position, tokenIndex, depth = position423, tokenIndex423, depth423
 					if buffer[position] != rune('G') {
-						goto l209
+						goto l421
 					}
 					position++
 				}
-			l211:
+			l423:
 				{
-					position213, tokenIndex213, depth213 := position, tokenIndex, depth
+					position425, tokenIndex425, depth425 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l214
+						goto l426
 					}
 					position++
-					goto l213
-				l214:
-					position, tokenIndex, depth = position213, tokenIndex213, depth213
+					goto l425
+				l426:
+					position, tokenIndex, depth = position425, tokenIndex425, depth425
 					if buffer[position] != rune('R') {
-						goto l209
+						goto l421
 					}
 					position++
 				}
-			l213:
+			l425:
 				{
-					position215, tokenIndex215, depth215 := position, tokenIndex, depth
+					position427, tokenIndex427, depth427 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l216
+						goto l428
 					}
 					position++
-					goto l215
-				l216:
-					position, tokenIndex, depth = position215, tokenIndex215, depth215
+					goto l427
+				l428:
+					position, tokenIndex, depth = position427, tokenIndex427, depth427
 					if buffer[position] != rune('O') {
-						goto l209
+						goto l421
 					}
 					position++
 				}
-			l215:
+			l427:
 				{
-					position217, tokenIndex217, depth217 := position, tokenIndex, depth
+					position429, tokenIndex429, depth429 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l218
+						goto l430
 					}
 					position++
-					goto l217
-				l218:
-					position, tokenIndex, depth = position217, tokenIndex217, depth217
+					goto l429
+				l430:
+					position, tokenIndex, depth = position429, tokenIndex429, depth429
 					if buffer[position] != rune('U') {
-						goto l209
+						goto l421
 					}
 					position++
 				}
-			l217:
+			l429:
 				{
-					position219, tokenIndex219, depth219 := position, tokenIndex, depth
+					position431, tokenIndex431, depth431 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l220
+						goto l432
 					}
 					position++
-					goto l219
-				l220:
-					position, tokenIndex, depth = position219, tokenIndex219, depth219
+					goto l431
+				l432:
+					position, tokenIndex, depth = position431, tokenIndex431, depth431
 					if buffer[position] != rune('P') {
-						goto l209
+						goto l421
 					}
 					position++
 				}
-			l219:
+			l431:
 				if !_rules[ruleKEY]() {
-					goto l209
+					goto l421
 				}
 				if !_rules[rule_]() {
-					goto l209
+					goto l421
 				}
 				{
-					position221, tokenIndex221, depth221 := position, tokenIndex, depth
+					position433, tokenIndex433, depth433 := position, tokenIndex, depth
 					if buffer[position] != rune('b') {
-						goto l222
+						goto l434
+					}
+					position++
+					goto l433
+				l434:
+					position, tokenIndex, depth = position433, tokenIndex433, depth433
+					if buffer[position] != rune('B') {
+						goto l421
+					}
+					position++
+				}
+			l433:
+				{
+					position435, tokenIndex435, depth435 := position, tokenIndex, depth
+					if buffer[position] != rune('y') {
+						goto l436
+					}
+					position++
+					goto l435
+				l436:
+					position, tokenIndex, depth = position435, tokenIndex435, depth435
+					if buffer[position] != rune('Y') {
+						goto l421
+					}
+					position++
+				}
+			l435:
+				if !_rules[ruleKEY]() {
+					goto l421
+				}
+				if !_rules[rule_]() {
+					goto l421
+				}
+				{
+					position437 := position
+					depth++
+					if !_rules[ruleCOLUMN_NAME]() {
+						goto l421
+					}
+					depth--
+					add(rulePegText, position437)
+				}
+				{
+					add(ruleAction60, position)
+				}
+			l439:
+				{
+					position440, tokenIndex440, depth440 := position, tokenIndex, depth
+					if !_rules[rule_]() {
+						goto l440
+					}
+					if !_rules[ruleCOMMA]() {
+						goto l440
+					}
+					if !_rules[rule_]() {
+						goto l440
+					}
+					{
+						position441 := position
+						depth++
+						if !_rules[ruleCOLUMN_NAME]() {
+							goto l440
+						}
+						depth--
+						add(rulePegText, position441)
+					}
+					{
+						add(ruleAction61, position)
+					}
+					goto l439
+				l440:
+					position, tokenIndex, depth = position440, tokenIndex440, depth440
+				}
+				depth--
+				add(rulegroupByClause, position422)
+			}
+			return true
+		l421:
+			position, tokenIndex, depth = position421, tokenIndex421, depth421
+			return false
+		},
+		/* 23 predicateClause <- <(_ (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) KEY _ predicate_1)> */
+		func() bool {
+			position443, tokenIndex443, depth443 := position, tokenIndex, depth
+			{
+				position444 := position
+				depth++
+				if !_rules[rule_]() {
+					goto l443
+				}
+				{
+					position445, tokenIndex445, depth445 := position, tokenIndex, depth
+					if buffer[position] != rune('w') {
+						goto l446
+					}
+					position++
+					goto l445
+				l446:
+					position, tokenIndex, depth = position445, tokenIndex445, depth445
+					if buffer[position] != rune('W') {
+						goto l443
+					}
+					position++
+				}
+			l445:
+				{
+					position447, tokenIndex447, depth447 := position, tokenIndex, depth
+					if buffer[position] != rune('h') {
+						goto l448
+					}
+					position++
+					goto l447
+				l448:
+					position, tokenIndex, depth = position447, tokenIndex447, depth447
+					if buffer[position] != rune('H') {
+						goto l443
+					}
+					position++
+				}
+			l447:
+				{
+					position449, tokenIndex449, depth449 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l450
+					}
+					position++
+					goto l449
+				l450:
+					position, tokenIndex, depth = position449, tokenIndex449, depth449
+					if buffer[position] != rune('E') {
+						goto l443
+					}
+					position++
+				}
+			l449:
+				{
+					position451, tokenIndex451, depth451 := position, tokenIndex, depth
+					if buffer[position] != rune('r') {
+						goto l452
 					}
 					position++
-					goto l221
-				l222:
-					position, tokenIndex, depth = position221, tokenIndex221, depth221
-					if buffer[position] != rune('B') {
-						goto l209
+					goto l451
+				l452:
+					position, tokenIndex, depth = position451, tokenIndex451, depth451
+					if buffer[position] != rune('R') {
+						goto l443
 					}
 					position++
 				}
-			l221:
+			l451:
 				{
-					position223, tokenIndex223, depth223 := position, tokenIndex, depth
-					if buffer[position] != rune('y') {
-						goto l224
+					position453, tokenIndex453, depth453 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l454
 					}
 					position++
-					goto l223
-				l224:
-					position, tokenIndex, depth = position223, tokenIndex223, depth223
-					if buffer[position] != rune('Y') {
-						goto l209
+					goto l453
+				l454:
+					position, tokenIndex, depth = position453, tokenIndex453, depth453
+					if buffer[position] != rune('E') {
+						goto l443
 					}
 					position++
 				}
-			l223:
+			l453:
 				if !_rules[ruleKEY]() {
-					goto l209
+					goto l443
 				}
 				if !_rules[rule_]() {
-					goto l209
-				}
-				{
-					position225 := position
-					depth++
-					if !_rules[ruleCOLUMN_NAME]() {
-						goto l209
-					}
-					depth--
-					add(rulePegText, position225)
-				}
-				{
-					add(ruleAction34, position)
+					goto l443
 				}
-			l227:
-				{
-					position228, tokenIndex228, depth228 := position, tokenIndex, depth
-					if !_rules[rule_]() {
-						goto l228
-					}
-					if !_rules[ruleCOMMA]() {
-						goto l228
-					}
-					if !_rules[rule_]() {
-						goto l228
-					}
-					{
-						position229 := position
-						depth++
-						if !_rules[ruleCOLUMN_NAME]() {
-							goto l228
-						}
-						depth--
-						add(rulePegText, position229)
-					}
-					{
-						add(ruleAction35, position)
-					}
-					goto l227
-				l228:
-					position, tokenIndex, depth = position228, tokenIndex228, depth228
+				if !_rules[rulepredicate_1]() {
+					goto l443
 				}
 				depth--
-				add(rulegroupByClause, position210)
+				add(rulepredicateClause, position444)
 			}
 			return true
-		l209:
-			position, tokenIndex, depth = position209, tokenIndex209, depth209
+		l443:
+			position, tokenIndex, depth = position443, tokenIndex443, depth443
 			return false
 		},
-		/* 17 predicateClause <- <(_ (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) KEY _ predicate_1)> */
-		nil,
-		/* 18 predicate_1 <- <((predicate_2 _ OP_OR predicate_1 Action36) / predicate_2)> */
+		/* 24 predicate_1 <- <((predicate_2 _ OP_OR predicate_1 Action62) / predicate_2)> */
 		func() bool {
-			position232, tokenIndex232, depth232 := position, tokenIndex, depth
+			position455, tokenIndex455, depth455 := position, tokenIndex, depth
 			{
-				position233 := position
+				position456 := position
 				depth++
 				{
-					position234, tokenIndex234, depth234 := position, tokenIndex, depth
+					position457, tokenIndex457, depth457 := position, tokenIndex, depth
 					if !_rules[rulepredicate_2]() {
-						goto l235
+						goto l458
 					}
 					if !_rules[rule_]() {
-						goto l235
+						goto l458
 					}
 					{
-						position236 := position
+						position459 := position
 						depth++
 						{
-							position237, tokenIndex237, depth237 := position, tokenIndex, depth
+							position460, tokenIndex460, depth460 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l238
+								goto l461
 							}
 							position++
-							goto l237
-						l238:
-							position, tokenIndex, depth = position237, tokenIndex237, depth237
+							goto l460
+						l461:
+							position, tokenIndex, depth = position460, tokenIndex460, depth460
 							if buffer[position] != rune('O') {
-								goto l235
+								goto l458
 							}
 							position++
 						}
-					l237:
+					l460:
 						{
-							position239, tokenIndex239, depth239 := position, tokenIndex, depth
+							position462, tokenIndex462, depth462 := position, tokenIndex, depth
 							if buffer[position] != rune('r') {
-								goto l240
+								goto l463
 							}
 							position++
-							goto l239
-						l240:
-							position, tokenIndex, depth = position239, tokenIndex239, depth239
+							goto l462
+						l463:
+							position, tokenIndex, depth = position462, tokenIndex462, depth462
 							if buffer[position] != rune('R') {
-								goto l235
+								goto l458
 							}
 							position++
 						}
-					l239:
+					l462:
 						if !_rules[ruleKEY]() {
-							goto l235
+							goto l458
 						}
 						depth--
-						add(ruleOP_OR, position236)
+						add(ruleOP_OR, position459)
 					}
 					if !_rules[rulepredicate_1]() {
-						goto l235
+						goto l458
 					}
 					{
-						add(ruleAction36, position)
+						add(ruleAction62, position)
 					}
-					goto l234
-				l235:
-					position, tokenIndex, depth = position234, tokenIndex234, depth234
+					goto l457
+				l458:
+					position, tokenIndex, depth = position457, tokenIndex457, depth457
 					if !_rules[rulepredicate_2]() {
-						goto l232
+						goto l455
 					}
 				}
-			l234:
+			l457:
 				depth--
-				add(rulepredicate_1, position233)
+				add(rulepredicate_1, position456)
 			}
 			return true
-		l232:
-			position, tokenIndex, depth = position232, tokenIndex232, depth232
+		l455:
+			position, tokenIndex, depth = position455, tokenIndex455, depth455
 			return false
 		},
-		/* 19 predicate_2 <- <((predicate_3 _ OP_AND predicate_2 Action37) / predicate_3)> */
+		/* 25 predicate_2 <- <((predicate_3 _ OP_AND predicate_2 Action63) / predicate_3)> */
 		func() bool {
-			position242, tokenIndex242, depth242 := position, tokenIndex, depth
+			position465, tokenIndex465, depth465 := position, tokenIndex, depth
 			{
-				position243 := position
+				position466 := position
 				depth++
 				{
-					position244, tokenIndex244, depth244 := position, tokenIndex, depth
+					position467, tokenIndex467, depth467 := position, tokenIndex, depth
 					if !_rules[rulepredicate_3]() {
-						goto l245
+						goto l468
 					}
 					if !_rules[rule_]() {
-						goto l245
+						goto l468
 					}
 					{
-						position246 := position
+						position469 := position
 						depth++
 						{
-							position247, tokenIndex247, depth247 := position, tokenIndex, depth
+							position470, tokenIndex470, depth470 := position, tokenIndex, depth
 							if buffer[position] != rune('a') {
-								goto l248
+								goto l471
 							}
 							position++
-							goto l247
-						l248:
-							position, tokenIndex, depth = position247, tokenIndex247, depth247
+							goto l470
+						l471:
+							position, tokenIndex, depth = position470, tokenIndex470, depth470
 							if buffer[position] != rune('A') {
-								goto l245
+								goto l468
 							}
 							position++
 						}
-					l247:
+					l470:
 						{
-							position249, tokenIndex249, depth249 := position, tokenIndex, depth
+							position472, tokenIndex472, depth472 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l250
+								goto l473
 							}
 							position++
-							goto l249
-						l250:
-							position, tokenIndex, depth = position249, tokenIndex249, depth249
+							goto l472
+						l473:
+							position, tokenIndex, depth = position472, tokenIndex472, depth472
 							if buffer[position] != rune('N') {
-								goto l245
+								goto l468
 							}
 							position++
 						}
-					l249:
+					l472:
 						{
-							position251, tokenIndex251, depth251 := position, tokenIndex, depth
+							position474, tokenIndex474, depth474 := position, tokenIndex, depth
 							if buffer[position] != rune('d') {
-								goto l252
+								goto l475
 							}
 							position++
-							goto l251
-						l252:
-							position, tokenIndex, depth = position251, tokenIndex251, depth251
+							goto l474
+						l475:
+							position, tokenIndex, depth = position474, tokenIndex474, depth474
 							if buffer[position] != rune('D') {
-								goto l245
+								goto l468
 							}
 							position++
 						}
-					l251:
+					l474:
 						if !_rules[ruleKEY]() {
-							goto l245
+							goto l468
 						}
 						depth--
-						add(ruleOP_AND, position246)
+						add(ruleOP_AND, position469)
 					}
 					if !_rules[rulepredicate_2]() {
-						goto l245
+						goto l468
 					}
 					{
-						add(ruleAction37, position)
+						add(ruleAction63, position)
 					}
-					goto l244
-				l245:
-					position, tokenIndex, depth = position244, tokenIndex244, depth244
+					goto l467
+				l468:
+					position, tokenIndex, depth = position467, tokenIndex467, depth467
 					if !_rules[rulepredicate_3]() {
-						goto l242
+						goto l465
 					}
 				}
-			l244:
+			l467:
 				depth--
-				add(rulepredicate_2, position243)
+				add(rulepredicate_2, position466)
 			}
 			return true
-		l242:
-			position, tokenIndex, depth = position242, tokenIndex242, depth242
+		l465:
+			position, tokenIndex, depth = position465, tokenIndex465, depth465
 			return false
 		},
-		/* 20 predicate_3 <- <((_ OP_NOT predicate_3 Action38) / (_ PAREN_OPEN predicate_1 _ PAREN_CLOSE) / tagMatcher)> */
+		/* 26 predicate_3 <- <((_ OP_NOT predicate_3 Action64) / (_ PAREN_OPEN predicate_1 _ PAREN_CLOSE) / tagMatcher)> */
 		func() bool {
-			position254, tokenIndex254, depth254 := position, tokenIndex, depth
+			position477, tokenIndex477, depth477 := position, tokenIndex, depth
 			{
-				position255 := position
+				position478 := position
 				depth++
 				{
-					position256, tokenIndex256, depth256 := position, tokenIndex, depth
+					position479, tokenIndex479, depth479 := position, tokenIndex, depth
 					if !_rules[rule_]() {
-						goto l257
+						goto l480
 					}
 					{
-						position258 := position
+						position481 := position
 						depth++
 						{
-							position259, tokenIndex259, depth259 := position, tokenIndex, depth
+							position482, tokenIndex482, depth482 := position, tokenIndex, depth
 							if buffer[position] != rune('n') {
-								goto l260
+								goto l483
 							}
 							position++
-							goto l259
-						l260:
-							position, tokenIndex, depth = position259, tokenIndex259, depth259
+							goto l482
+						l483:
+							position, tokenIndex, depth = position482, tokenIndex482, depth482
 							if buffer[position] != rune('N') {
-								goto l257
+								goto l480
 							}
 							position++
 						}
-					l259:
+					l482:
 						{
-							position261, tokenIndex261, depth261 := position, tokenIndex, depth
+							position484, tokenIndex484, depth484 := position, tokenIndex, depth
 							if buffer[position] != rune('o') {
-								goto l262
+								goto l485
 							}
 							position++
-							goto l261
-						l262:
-							position, tokenIndex, depth = position261, tokenIndex261, depth261
+							goto l484
+						l485:
+							position, tokenIndex, depth = position484, tokenIndex484, depth484
 							if buffer[position] != rune('O') {
-								goto l257
+								goto l480
 							}
 							position++
 						}
-					l261:
+					l484:
 						{
-							position263, tokenIndex263, depth263 := position, tokenIndex, depth
+							position486, tokenIndex486, depth486 := position, tokenIndex, depth
 							if buffer[position] != rune('t') {
-								goto l264
+								goto l487
 							}
 							position++
-							goto l263
-						l264:
-							position, tokenIndex, depth = position263, tokenIndex263, depth263
+							goto l486
+						l487:
+							position, tokenIndex, depth = position486, tokenIndex486, depth486
 							if buffer[position] != rune('T') {
-								goto l257
+								goto l480
 							}
 							position++
 						}
-					l263:
+					l486:
 						if !_rules[ruleKEY]() {
-							goto l257
+							goto l480
 						}
 						depth--
-						add(ruleOP_NOT, position258)
+						add(ruleOP_NOT, position481)
 					}
 					if !_rules[rulepredicate_3]() {
-						goto l257
+						goto l480
 					}
 					{
-						add(ruleAction38, position)
+						add(ruleAction64, position)
 					}
-					goto l256
-				l257:
-					position, tokenIndex, depth = position256, tokenIndex256, depth256
+					goto l479
+				l480:
+					position, tokenIndex, depth = position479, tokenIndex479, depth479
 					if !_rules[rule_]() {
-						goto l266
+						goto l489
 					}
 					if !_rules[rulePAREN_OPEN]() {
-						goto l266
+						goto l489
 					}
 					if !_rules[rulepredicate_1]() {
-						goto l266
+						goto l489
 					}
 					if !_rules[rule_]() {
-						goto l266
+						goto l489
 					}
 					if !_rules[rulePAREN_CLOSE]() {
-						goto l266
+						goto l489
 					}
-					goto l256
-				l266:
-					position, tokenIndex, depth = position256, tokenIndex256, depth256
+					goto l479
+				l489:
+					position, tokenIndex, depth = position479, tokenIndex479, depth479
 					{
-						position267 := position
+						position490 := position
 						depth++
 						{
-							position268, tokenIndex268, depth268 := position, tokenIndex, depth
+							position491, tokenIndex491, depth491 := position, tokenIndex, depth
 							if !_rules[ruletagName]() {
-								goto l269
+								goto l492
 							}
 							if !_rules[rule_]() {
-								goto l269
+								goto l492
 							}
 							if buffer[position] != rune('=') {
-								goto l269
+								goto l492
 							}
 							position++
 							if !_rules[ruleliteralString]() {
-								goto l269
+								goto l492
 							}
 							{
-								add(ruleAction39, position)
+								add(ruleAction65, position)
 							}
-							goto l268
-						l269:
-							position, tokenIndex, depth = position268, tokenIndex268, depth268
+							goto l491
+						l492:
+							position, tokenIndex, depth = position491, tokenIndex491, depth491
 							if !_rules[ruletagName]() {
-								goto l271
+								goto l494
 							}
 							if !_rules[rule_]() {
-								goto l271
+								goto l494
 							}
 							if buffer[position] != rune('!') {
-								goto l271
+								goto l494
 							}
 							position++
 							if buffer[position] != rune('=') {
-								goto l271
+								goto l494
 							}
 							position++
 							if !_rules[ruleliteralString]() {
-								goto l271
+								goto l494
 							}
 							{
-								add(ruleAction40, position)
+								add(ruleAction66, position)
 							}
-							goto l268
-						l271:
-							position, tokenIndex, depth = position268, tokenIndex268, depth268
+							goto l491
+						l494:
+							position, tokenIndex, depth = position491, tokenIndex491, depth491
 							if !_rules[ruletagName]() {
-								goto l273
+								goto l496
 							}
 							if !_rules[rule_]() {
-								goto l273
+								goto l496
 							}
 							{
-								position274, tokenIndex274, depth274 := position, tokenIndex, depth
+								position497, tokenIndex497, depth497 := position, tokenIndex, depth
 								if buffer[position] != rune('m') {
-									goto l275
+									goto l498
 								}
 								position++
-								goto l274
-							l275:
-								position, tokenIndex, depth = position274, tokenIndex274, depth274
+								goto l497
+							l498:
+								position, tokenIndex, depth = position497, tokenIndex497, depth497
 								if buffer[position] != rune('M') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l274:
+						l497:
 							{
-								position276, tokenIndex276, depth276 := position, tokenIndex, depth
+								position499, tokenIndex499, depth499 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l277
+									goto l500
 								}
 								position++
-								goto l276
-							l277:
-								position, tokenIndex, depth = position276, tokenIndex276, depth276
+								goto l499
+							l500:
+								position, tokenIndex, depth = position499, tokenIndex499, depth499
 								if buffer[position] != rune('A') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l276:
+						l499:
 							{
-								position278, tokenIndex278, depth278 := position, tokenIndex, depth
+								position501, tokenIndex501, depth501 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l279
+									goto l502
 								}
 								position++
-								goto l278
-							l279:
-								position, tokenIndex, depth = position278, tokenIndex278, depth278
+								goto l501
+							l502:
+								position, tokenIndex, depth = position501, tokenIndex501, depth501
 								if buffer[position] != rune('T') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l278:
+						l501:
 							{
-								position280, tokenIndex280, depth280 := position, tokenIndex, depth
+								position503, tokenIndex503, depth503 := position, tokenIndex, depth
 								if buffer[position] != rune('c') {
-									goto l281
+									goto l504
 								}
 								position++
-								goto l280
-							l281:
-								position, tokenIndex, depth = position280, tokenIndex280, depth280
+								goto l503
+							l504:
+								position, tokenIndex, depth = position503, tokenIndex503, depth503
 								if buffer[position] != rune('C') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l280:
+						l503:
 							{
-								position282, tokenIndex282, depth282 := position, tokenIndex, depth
+								position505, tokenIndex505, depth505 := position, tokenIndex, depth
 								if buffer[position] != rune('h') {
-									goto l283
+									goto l506
 								}
 								position++
-								goto l282
-							l283:
-								position, tokenIndex, depth = position282, tokenIndex282, depth282
+								goto l505
+							l506:
+								position, tokenIndex, depth = position505, tokenIndex505, depth505
 								if buffer[position] != rune('H') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l282:
+						l505:
 							{
-								position284, tokenIndex284, depth284 := position, tokenIndex, depth
+								position507, tokenIndex507, depth507 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l285
+									goto l508
 								}
 								position++
-								goto l284
-							l285:
-								position, tokenIndex, depth = position284, tokenIndex284, depth284
+								goto l507
+							l508:
+								position, tokenIndex, depth = position507, tokenIndex507, depth507
 								if buffer[position] != rune('E') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l284:
+						l507:
 							{
-								position286, tokenIndex286, depth286 := position, tokenIndex, depth
+								position509, tokenIndex509, depth509 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l287
+									goto l510
 								}
 								position++
-								goto l286
-							l287:
-								position, tokenIndex, depth = position286, tokenIndex286, depth286
+								goto l509
+							l510:
+								position, tokenIndex, depth = position509, tokenIndex509, depth509
 								if buffer[position] != rune('S') {
-									goto l273
+									goto l496
 								}
 								position++
 							}
-						l286:
+						l509:
 							if !_rules[ruleKEY]() {
-								goto l273
+								goto l496
 							}
 							if !_rules[ruleliteralString]() {
-								goto l273
+								goto l496
 							}
 							{
-								add(ruleAction41, position)
+								add(ruleAction67, position)
 							}
-							goto l268
-						l273:
-							position, tokenIndex, depth = position268, tokenIndex268, depth268
+							goto l491
+						l496:
+							position, tokenIndex, depth = position491, tokenIndex491, depth491
 							if !_rules[ruletagName]() {
-								goto l254
+								goto l477
 							}
 							if !_rules[rule_]() {
-								goto l254
+								goto l477
 							}
 							{
-								position289, tokenIndex289, depth289 := position, tokenIndex, depth
+								position512, tokenIndex512, depth512 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l290
+									goto l513
 								}
 								position++
-								goto l289
-							l290:
-								position, tokenIndex, depth = position289, tokenIndex289, depth289
+								goto l512
+							l513:
+								position, tokenIndex, depth = position512, tokenIndex512, depth512
 								if buffer[position] != rune('I') {
-									goto l254
+									goto l477
 								}
 								position++
 							}
-						l289:
+						l512:
 							{
-								position291, tokenIndex291, depth291 := position, tokenIndex, depth
+								position514, tokenIndex514, depth514 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l292
+									goto l515
 								}
 								position++
-								goto l291
-							l292:
-								position, tokenIndex, depth = position291, tokenIndex291, depth291
+								goto l514
+							l515:
+								position, tokenIndex, depth = position514, tokenIndex514, depth514
 								if buffer[position] != rune('N') {
-									goto l254
+									goto l477
 								}
 								position++
 							}
-						l291:
+						l514:
 							if !_rules[ruleKEY]() {
-								goto l254
+								goto l477
 							}
 							{
-								position293 := position
+								position516 := position
 								depth++
 								{
-									add(ruleAction44, position)
+									add(ruleAction70, position)
 								}
 								if !_rules[rule_]() {
-									goto l254
+									goto l477
 								}
 								if !_rules[rulePAREN_OPEN]() {
-									goto l254
+									goto l477
 								}
 								if !_rules[ruleliteralListString]() {
-									goto l254
+									goto l477
 								}
-							l295:
+							l518:
 								{
-									position296, tokenIndex296, depth296 := position, tokenIndex, depth
+									position519, tokenIndex519, depth519 := position, tokenIndex, depth
 									if !_rules[rule_]() {
-										goto l296
+										goto l519
 									}
 									if !_rules[ruleCOMMA]() {
-										goto l296
+										goto l519
 									}
 									if !_rules[ruleliteralListString]() {
-										goto l296
+										goto l519
 									}
-									goto l295
-								l296:
-									position, tokenIndex, depth = position296, tokenIndex296, depth296
+									goto l518
+								l519:
+									position, tokenIndex, depth = position519, tokenIndex519, depth519
 								}
 								if !_rules[rule_]() {
-									goto l254
+									goto l477
 								}
 								if !_rules[rulePAREN_CLOSE]() {
-									goto l254
+									goto l477
 								}
 								depth--
-								add(ruleliteralList, position293)
+								add(ruleliteralList, position516)
 							}
 							{
-								add(ruleAction42, position)
+								add(ruleAction68, position)
 							}
 						}
-					l268:
+					l491:
 						depth--
-						add(ruletagMatcher, position267)
+						add(ruletagMatcher, position490)
 					}
 				}
-			l256:
+			l479:
 				depth--
-				add(rulepredicate_3, position255)
+				add(rulepredicate_3, position478)
 			}
 			return true
-		l254:
-			position, tokenIndex, depth = position254, tokenIndex254, depth254
+		l477:
+			position, tokenIndex, depth = position477, tokenIndex477, depth477
 			return false
 		},
-		/* 21 tagMatcher <- <((tagName _ '=' literalString Action39) / (tagName _ ('!' '=') literalString Action40) / (tagName _ (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) KEY literalString Action41) / (tagName _ (('i' / 'I') ('n' / 'N')) KEY literalList Action42))> */
+		/* 27 tagMatcher <- <((tagName _ '=' literalString Action65) / (tagName _ ('!' '=') literalString Action66) / (tagName _ (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) KEY literalString Action67) / (tagName _ (('i' / 'I') ('n' / 'N')) KEY literalList Action68))> */
 		nil,
-		/* 22 literalString <- <(_ STRING Action43)> */
+		/* 28 literalString <- <(_ STRING Action69)> */
 		func() bool {
-			position299, tokenIndex299, depth299 := position, tokenIndex, depth
+			position522, tokenIndex522, depth522 := position, tokenIndex, depth
 			{
-				position300 := position
+				position523 := position
 				depth++
 				if !_rules[rule_]() {
-					goto l299
+					goto l522
 				}
 				if !_rules[ruleSTRING]() {
-					goto l299
+					goto l522
 				}
 				{
-					add(ruleAction43, position)
+					add(ruleAction69, position)
 				}
 				depth--
-				add(ruleliteralString, position300)
+				add(ruleliteralString, position523)
 			}
 			return true
-		l299:
-			position, tokenIndex, depth = position299, tokenIndex299, depth299
+		l522:
+			position, tokenIndex, depth = position522, tokenIndex522, depth522
 			return false
 		},
-		/* 23 literalList <- <(Action44 _ PAREN_OPEN literalListString (_ COMMA literalListString)* _ PAREN_CLOSE)> */
+		/* 29 literalList <- <(Action70 _ PAREN_OPEN literalListString (_ COMMA literalListString)* _ PAREN_CLOSE)> */
 		nil,
-		/* 24 literalListString <- <(_ STRING Action45)> */
+		/* 30 literalListString <- <(_ STRING Action71)> */
 		func() bool {
-			position303, tokenIndex303, depth303 := position, tokenIndex, depth
+			position526, tokenIndex526, depth526 := position, tokenIndex, depth
 			{
-				position304 := position
+				position527 := position
 				depth++
 				if !_rules[rule_]() {
-					goto l303
+					goto l526
 				}
 				if !_rules[ruleSTRING]() {
-					goto l303
+					goto l526
 				}
 				{
-					add(ruleAction45, position)
+					add(ruleAction71, position)
 				}
 				depth--
-				add(ruleliteralListString, position304)
+				add(ruleliteralListString, position527)
 			}
 			return true
-		l303:
-			position, tokenIndex, depth = position303, tokenIndex303, depth303
+		l526:
+			position, tokenIndex, depth = position526, tokenIndex526, depth526
 			return false
 		},
-		/* 25 tagName <- <(_ <TAG_NAME> Action46)> */
+		/* 31 tagName <- <(_ <TAG_NAME> Action72)> */
 		func() bool {
-			position306, tokenIndex306, depth306 := position, tokenIndex, depth
+			position529, tokenIndex529, depth529 := position, tokenIndex, depth
 			{
-				position307 := position
+				position530 := position
 				depth++
 				if !_rules[rule_]() {
-					goto l306
+					goto l529
 				}
 				{
-					position308 := position
+					position531 := position
 					depth++
-					{
-						position309 := position
-						depth++
-						if !_rules[ruleIDENTIFIER]() {
-							goto l306
-						}
-						depth--
-						add(ruleTAG_NAME, position309)
+					if !_rules[ruleTAG_NAME]() {
+						goto l529
 					}
 					depth--
-					add(rulePegText, position308)
+					add(rulePegText, position531)
 				}
 				{
-					add(ruleAction46, position)
+					add(ruleAction72, position)
 				}
 				depth--
-				add(ruletagName, position307)
+				add(ruletagName, position530)
 			}
 			return true
-		l306:
-			position, tokenIndex, depth = position306, tokenIndex306, depth306
+		l529:
+			position, tokenIndex, depth = position529, tokenIndex529, depth529
 			return false
 		},
-		/* 26 COLUMN_NAME <- <IDENTIFIER> */
+		/* 32 COLUMN_NAME <- <IDENTIFIER> */
 		func() bool {
-			position311, tokenIndex311, depth311 := position, tokenIndex, depth
+			position533, tokenIndex533, depth533 := position, tokenIndex, depth
 			{
-				position312 := position
+				position534 := position
 				depth++
 				if !_rules[ruleIDENTIFIER]() {
-					goto l311
+					goto l533
 				}
 				depth--
-				add(ruleCOLUMN_NAME, position312)
+				add(ruleCOLUMN_NAME, position534)
 			}
 			return true
-		l311:
-			position, tokenIndex, depth = position311, tokenIndex311, depth311
+		l533:
+			position, tokenIndex, depth = position533, tokenIndex533, depth533
 			return false
 		},
-		/* 27 METRIC_NAME <- <IDENTIFIER> */
-		nil,
-		/* 28 TAG_NAME <- <IDENTIFIER> */
-		nil,
-		/* 29 IDENTIFIER <- <(('`' CHAR* '`') / (_ !(KEYWORD KEY) ID_SEGMENT ('.' ID_SEGMENT)*))> */
+		/* 33 METRIC_NAME <- <IDENTIFIER> */
+		func() bool {
+			position535, tokenIndex535, depth535 := position, tokenIndex, depth
+			{
+				position536 := position
+				depth++
+				if !_rules[ruleIDENTIFIER]() {
+					goto l535
+				}
+				depth--
+				add(ruleMETRIC_NAME, position536)
+			}
+			return true
+		l535:
+			position, tokenIndex, depth = position535, tokenIndex535, depth535
+			return false
+		},
+		/* 34 TAG_NAME <- <IDENTIFIER> */
+		func() bool {
+			position537, tokenIndex537, depth537 := position, tokenIndex, depth
+			{
+				position538 := position
+				depth++
+				if !_rules[ruleIDENTIFIER]() {
+					goto l537
+				}
+				depth--
+				add(ruleTAG_NAME, position538)
+			}
+			return true
+		l537:
+			position, tokenIndex, depth = position537, tokenIndex537, depth537
+			return false
+		},
+		/* 35 IDENTIFIER <- <(('`' CHAR* '`') / (_ !(KEYWORD KEY) ID_SEGMENT ('.' ID_SEGMENT)*))> */
 		func() bool {
-			position315, tokenIndex315, depth315 := position, tokenIndex, depth
+			position539, tokenIndex539, depth539 := position, tokenIndex, depth
 			{
-				position316 := position
+				position540 := position
 				depth++
 				{
-					position317, tokenIndex317, depth317 := position, tokenIndex, depth
+					position541, tokenIndex541, depth541 := position, tokenIndex, depth
 					if buffer[position] != rune('`') {
-						goto l318
+						goto l542
 					}
 					position++
-				l319:
+				l543:
 					{
-						position320, tokenIndex320, depth320 := position, tokenIndex, depth
+						position544, tokenIndex544, depth544 := position, tokenIndex, depth
 						if !_rules[ruleCHAR]() {
-							goto l320
+							goto l544
 						}
-						goto l319
-					l320:
-						position, tokenIndex, depth = position320, tokenIndex320, depth320
+						goto l543
+					l544:
+						position, tokenIndex, depth = position544, tokenIndex544, depth544
 					}
 					if buffer[position] != rune('`') {
-						goto l318
+						goto l542
 					}
 					position++
-					goto l317
-				l318:
-					position, tokenIndex, depth = position317, tokenIndex317, depth317
+					goto l541
+				l542:
+					position, tokenIndex, depth = position541, tokenIndex541, depth541
 					if !_rules[rule_]() {
-						goto l315
+						goto l539
 					}
 					{
-						position321, tokenIndex321, depth321 := position, tokenIndex, depth
+						position545, tokenIndex545, depth545 := position, tokenIndex, depth
 						{
-							position322 := position
+							position546 := position
 							depth++
 							{
-								position323, tokenIndex323, depth323 := position, tokenIndex, depth
+								position547, tokenIndex547, depth547 := position, tokenIndex, depth
 								{
-									position325, tokenIndex325, depth325 := position, tokenIndex, depth
+									position549, tokenIndex549, depth549 := position, tokenIndex, depth
 									if buffer[position] != rune('a') {
-										goto l326
+										goto l550
 									}
 									position++
-									goto l325
-								l326:
-									position, tokenIndex, depth = position325, tokenIndex325, depth325
+									goto l549
+								l550:
+									position, tokenIndex, depth = position549, tokenIndex549, depth549
 									if buffer[position] != rune('A') {
-										goto l324
+										goto l548
 									}
 									position++
 								}
-							l325:
+							l549:
 								{
-									position327, tokenIndex327, depth327 := position, tokenIndex, depth
+									position551, tokenIndex551, depth551 := position, tokenIndex, depth
 									if buffer[position] != rune('l') {
-										goto l328
+										goto l552
 									}
 									position++
-									goto l327
-								l328:
-									position, tokenIndex, depth = position327, tokenIndex327, depth327
+									goto l551
+								l552:
+									position, tokenIndex, depth = position551, tokenIndex551, depth551
 									if buffer[position] != rune('L') {
-										goto l324
+										goto l548
 									}
 									position++
 								}
-							l327:
+							l551:
 								{
-									position329, tokenIndex329, depth329 := position, tokenIndex, depth
+									position553, tokenIndex553, depth553 := position, tokenIndex, depth
 									if buffer[position] != rune('l') {
-										goto l330
+										goto l554
 									}
 									position++
-									goto l329
-								l330:
-									position, tokenIndex, depth = position329, tokenIndex329, depth329
+									goto l553
+								l554:
+									position, tokenIndex, depth = position553, tokenIndex553, depth553
 									if buffer[position] != rune('L') {
-										goto l324
+										goto l548
 									}
 									position++
 								}
-							l329:
-								goto l323
-							l324:
-								position, tokenIndex, depth = position323, tokenIndex323, depth323
+							l553:
+								goto l547
+							l548:
+								position, tokenIndex, depth = position547, tokenIndex547, depth547
 								{
-									position332, tokenIndex332, depth332 := position, tokenIndex, depth
+									position556, tokenIndex556, depth556 := position, tokenIndex, depth
 									if buffer[position] != rune('a') {
-										goto l333
+										goto l557
 									}
 									position++
-									goto l332
-								l333:
-									position, tokenIndex, depth = position332, tokenIndex332, depth332
+									goto l556
+								l557:
+									position, tokenIndex, depth = position556, tokenIndex556, depth556
 									if buffer[position] != rune('A') {
-										goto l331
+										goto l555
 									}
 									position++
 								}
-							l332:
+							l556:
 								{
-									position334, tokenIndex334, depth334 := position, tokenIndex, depth
+									position558, tokenIndex558, depth558 := position, tokenIndex, depth
 									if buffer[position] != rune('n') {
-										goto l335
+										goto l559
 									}
 									position++
-									goto l334
-								l335:
-									position, tokenIndex, depth = position334, tokenIndex334, depth334
+									goto l558
+								l559:
+									position, tokenIndex, depth = position558, tokenIndex558, depth558
 									if buffer[position] != rune('N') {
-										goto l331
+										goto l555
 									}
 									position++
 								}
-							l334:
+							l558:
 								{
-									position336, tokenIndex336, depth336 := position, tokenIndex, depth
+									position560, tokenIndex560, depth560 := position, tokenIndex, depth
 									if buffer[position] != rune('d') {
-										goto l337
+										goto l561
 									}
 									position++
-									goto l336
-								l337:
-									position, tokenIndex, depth = position336, tokenIndex336, depth336
+									goto l560
+								l561:
+									position, tokenIndex, depth = position560, tokenIndex560, depth560
 									if buffer[position] != rune('D') {
-										goto l331
+										goto l555
+									}
+									position++
+								}
+							l560:
+								goto l547
+							l555:
+								position, tokenIndex, depth = position547, tokenIndex547, depth547
+								{
+									position563, tokenIndex563, depth563 := position, tokenIndex, depth
+									if buffer[position] != rune('b') {
+										goto l564
+									}
+									position++
+									goto l563
+								l564:
+									position, tokenIndex, depth = position563, tokenIndex563, depth563
+									if buffer[position] != rune('B') {
+										goto l562
+									}
+									position++
+								}
+							l563:
+								{
+									position565, tokenIndex565, depth565 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l566
+									}
+									position++
+									goto l565
+								l566:
+									position, tokenIndex, depth = position565, tokenIndex565, depth565
+									if buffer[position] != rune('O') {
+										goto l562
+									}
+									position++
+								}
+							l565:
+								{
+									position567, tokenIndex567, depth567 := position, tokenIndex, depth
+									if buffer[position] != rune('o') {
+										goto l568
+									}
+									position++
+									goto l567
+								l568:
+									position, tokenIndex, depth = position567, tokenIndex567, depth567
+									if buffer[position] != rune('O') {
+										goto l562
+									}
+									position++
+								}
+							l567:
+								{
+									position569, tokenIndex569, depth569 := position, tokenIndex, depth
+									if buffer[position] != rune('l') {
+										goto l570
+									}
+									position++
+									goto l569
+								l570:
+									position, tokenIndex, depth = position569, tokenIndex569, depth569
+									if buffer[position] != rune('L') {
+										goto l562
 									}
 									position++
 								}
-							l336:
-								goto l323
-							l331:
-								position, tokenIndex, depth = position323, tokenIndex323, depth323
+							l569:
+								goto l547
+							l562:
+								position, tokenIndex, depth = position547, tokenIndex547, depth547
 								{
-									position339, tokenIndex339, depth339 := position, tokenIndex, depth
+									position572, tokenIndex572, depth572 := position, tokenIndex, depth
 									if buffer[position] != rune('m') {
-										goto l340
+										goto l573
 									}
 									position++
-									goto l339
-								l340:
-									position, tokenIndex, depth = position339, tokenIndex339, depth339
+									goto l572
+								l573:
+									position, tokenIndex, depth = position572, tokenIndex572, depth572
 									if buffer[position] != rune('M') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l339:
+							l572:
 								{
-									position341, tokenIndex341, depth341 := position, tokenIndex, depth
+									position574, tokenIndex574, depth574 := position, tokenIndex, depth
 									if buffer[position] != rune('a') {
-										goto l342
+										goto l575
 									}
 									position++
-									goto l341
-								l342:
-									position, tokenIndex, depth = position341, tokenIndex341, depth341
+									goto l574
+								l575:
+									position, tokenIndex, depth = position574, tokenIndex574, depth574
 									if buffer[position] != rune('A') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l341:
+							l574:
 								{
-									position343, tokenIndex343, depth343 := position, tokenIndex, depth
+									position576, tokenIndex576, depth576 := position, tokenIndex, depth
 									if buffer[position] != rune('t') {
-										goto l344
+										goto l577
 									}
 									position++
-									goto l343
-								l344:
-									position, tokenIndex, depth = position343, tokenIndex343, depth343
+									goto l576
+								l577:
+									position, tokenIndex, depth = position576, tokenIndex576, depth576
 									if buffer[position] != rune('T') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l343:
+							l576:
 								{
-									position345, tokenIndex345, depth345 := position, tokenIndex, depth
+									position578, tokenIndex578, depth578 := position, tokenIndex, depth
 									if buffer[position] != rune('c') {
-										goto l346
+										goto l579
 									}
 									position++
-									goto l345
-								l346:
-									position, tokenIndex, depth = position345, tokenIndex345, depth345
+									goto l578
+								l579:
+									position, tokenIndex, depth = position578, tokenIndex578, depth578
 									if buffer[position] != rune('C') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l345:
+							l578:
 								{
-									position347, tokenIndex347, depth347 := position, tokenIndex, depth
+									position580, tokenIndex580, depth580 := position, tokenIndex, depth
 									if buffer[position] != rune('h') {
-										goto l348
+										goto l581
 									}
 									position++
-									goto l347
-								l348:
-									position, tokenIndex, depth = position347, tokenIndex347, depth347
+									goto l580
+								l581:
+									position, tokenIndex, depth = position580, tokenIndex580, depth580
 									if buffer[position] != rune('H') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l347:
+							l580:
 								{
-									position349, tokenIndex349, depth349 := position, tokenIndex, depth
+									position582, tokenIndex582, depth582 := position, tokenIndex, depth
 									if buffer[position] != rune('e') {
-										goto l350
+										goto l583
 									}
 									position++
-									goto l349
-								l350:
-									position, tokenIndex, depth = position349, tokenIndex349, depth349
+									goto l582
+								l583:
+									position, tokenIndex, depth = position582, tokenIndex582, depth582
 									if buffer[position] != rune('E') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l349:
+							l582:
 								{
-									position351, tokenIndex351, depth351 := position, tokenIndex, depth
+									position584, tokenIndex584, depth584 := position, tokenIndex, depth
 									if buffer[position] != rune('s') {
-										goto l352
+										goto l585
 									}
 									position++
-									goto l351
-								l352:
-									position, tokenIndex, depth = position351, tokenIndex351, depth351
+									goto l584
+								l585:
+									position, tokenIndex, depth = position584, tokenIndex584, depth584
 									if buffer[position] != rune('S') {
-										goto l338
+										goto l571
 									}
 									position++
 								}
-							l351:
-								goto l323
-							l338:
-								position, tokenIndex, depth = position323, tokenIndex323, depth323
+							l584:
+								goto l547
+							l571:
+								position, tokenIndex, depth = position547, tokenIndex547, depth547
 								{
-									position354, tokenIndex354, depth354 := position, tokenIndex, depth
+									position587, tokenIndex587, depth587 := position, tokenIndex, depth
 									if buffer[position] != rune('s') {
-										goto l355
+										goto l588
 									}
 									position++
-									goto l354
-								l355:
-									position, tokenIndex, depth = position354, tokenIndex354, depth354
+									goto l587
+								l588:
+									position, tokenIndex, depth = position587, tokenIndex587, depth587
 									if buffer[position] != rune('S') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l354:
+							l587:
 								{
-									position356, tokenIndex356, depth356 := position, tokenIndex, depth
+									position589, tokenIndex589, depth589 := position, tokenIndex, depth
 									if buffer[position] != rune('e') {
-										goto l357
+										goto l590
 									}
 									position++
-									goto l356
-								l357:
-									position, tokenIndex, depth = position356, tokenIndex356, depth356
+									goto l589
+								l590:
+									position, tokenIndex, depth = position589, tokenIndex589, depth589
 									if buffer[position] != rune('E') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l356:
+							l589:
 								{
-									position358, tokenIndex358, depth358 := position, tokenIndex, depth
+									position591, tokenIndex591, depth591 := position, tokenIndex, depth
 									if buffer[position] != rune('l') {
-										goto l359
+										goto l592
 									}
 									position++
-									goto l358
-								l359:
-									position, tokenIndex, depth = position358, tokenIndex358, depth358
+									goto l591
+								l592:
+									position, tokenIndex, depth = position591, tokenIndex591, depth591
 									if buffer[position] != rune('L') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l358:
+							l591:
 								{
-									position360, tokenIndex360, depth360 := position, tokenIndex, depth
+									position593, tokenIndex593, depth593 := position, tokenIndex, depth
 									if buffer[position] != rune('e') {
-										goto l361
+										goto l594
 									}
 									position++
-									goto l360
-								l361:
-									position, tokenIndex, depth = position360, tokenIndex360, depth360
+									goto l593
+								l594:
+									position, tokenIndex, depth = position593, tokenIndex593, depth593
 									if buffer[position] != rune('E') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l360:
+							l593:
 								{
-									position362, tokenIndex362, depth362 := position, tokenIndex, depth
+									position595, tokenIndex595, depth595 := position, tokenIndex, depth
 									if buffer[position] != rune('c') {
-										goto l363
+										goto l596
 									}
 									position++
-									goto l362
-								l363:
-									position, tokenIndex, depth = position362, tokenIndex362, depth362
+									goto l595
+								l596:
+									position, tokenIndex, depth = position595, tokenIndex595, depth595
 									if buffer[position] != rune('C') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l362:
+							l595:
 								{
-									position364, tokenIndex364, depth364 := position, tokenIndex, depth
+									position597, tokenIndex597, depth597 := position, tokenIndex, depth
 									if buffer[position] != rune('t') {
-										goto l365
+										goto l598
 									}
 									position++
-									goto l364
-								l365:
-									position, tokenIndex, depth = position364, tokenIndex364, depth364
+									goto l597
+								l598:
+									position, tokenIndex, depth = position597, tokenIndex597, depth597
 									if buffer[position] != rune('T') {
-										goto l353
+										goto l586
 									}
 									position++
 								}
-							l364:
-								goto l323
-							l353:
-								position, tokenIndex, depth = position323, tokenIndex323, depth323
+							l597:
+								goto l547
+							l586:
+								position, tokenIndex, depth = position547, tokenIndex547, depth547
 								{
 									switch buffer[position] {
 									case 'M', 'm':
 										{
-											position367, tokenIndex367, depth367 := position, tokenIndex, depth
+											position600, tokenIndex600, depth600 := position, tokenIndex, depth
 											if buffer[position] != rune('m') {
-												goto l368
+												goto l601
 											}
 											position++
-											goto l367
-										l368:
-											position, tokenIndex, depth = position367, tokenIndex367, depth367
+											goto l600
+										l601:
+											position, tokenIndex, depth = position600, tokenIndex600, depth600
 											if buffer[position] != rune('M') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l367:
+									l600:
 										{
-											position369, tokenIndex369, depth369 := position, tokenIndex, depth
+											position602, tokenIndex602, depth602 := position, tokenIndex, depth
 											if buffer[position] != rune('e') {
-												goto l370
+												goto l603
 											}
 											position++
-											goto l369
-										l370:
-											position, tokenIndex, depth = position369, tokenIndex369, depth369
+											goto l602
+										l603:
+											position, tokenIndex, depth = position602, tokenIndex602, depth602
 											if buffer[position] != rune('E') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l369:
+									l602:
 										{
-											position371, tokenIndex371, depth371 := position, tokenIndex, depth
+											position604, tokenIndex604, depth604 := position, tokenIndex, depth
 											if buffer[position] != rune('t') {
-												goto l372
+												goto l605
 											}
 											position++
-											goto l371
-										l372:
-											position, tokenIndex, depth = position371, tokenIndex371, depth371
+											goto l604
+										l605:
+											position, tokenIndex, depth = position604, tokenIndex604, depth604
 											if buffer[position] != rune('T') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l371:
+									l604:
 										{
-											position373, tokenIndex373, depth373 := position, tokenIndex, depth
+											position606, tokenIndex606, depth606 := position, tokenIndex, depth
 											if buffer[position] != rune('r') {
-												goto l374
+												goto l607
 											}
 											position++
-											goto l373
-										l374:
-											position, tokenIndex, depth = position373, tokenIndex373, depth373
+											goto l606
+										l607:
+											position, tokenIndex, depth = position606, tokenIndex606, depth606
 											if buffer[position] != rune('R') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l373:
+									l606:
 										{
-											position375, tokenIndex375, depth375 := position, tokenIndex, depth
+											position608, tokenIndex608, depth608 := position, tokenIndex, depth
 											if buffer[position] != rune('i') {
-												goto l376
+												goto l609
 											}
 											position++
-											goto l375
-										l376:
-											position, tokenIndex, depth = position375, tokenIndex375, depth375
+											goto l608
+										l609:
+											position, tokenIndex, depth = position608, tokenIndex608, depth608
 											if buffer[position] != rune('I') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l375:
+									l608:
 										{
-											position377, tokenIndex377, depth377 := position, tokenIndex, depth
+											position610, tokenIndex610, depth610 := position, tokenIndex, depth
 											if buffer[position] != rune('c') {
-												goto l378
+												goto l611
 											}
 											position++
-											goto l377
-										l378:
-											position, tokenIndex, depth = position377, tokenIndex377, depth377
+											goto l610
+										l611:
+											position, tokenIndex, depth = position610, tokenIndex610, depth610
 											if buffer[position] != rune('C') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l377:
+									l610:
 										{
-											position379, tokenIndex379, depth379 := position, tokenIndex, depth
+											position612, tokenIndex612, depth612 := position, tokenIndex, depth
 											if buffer[position] != rune('s') {
-												goto l380
+												goto l613
 											}
 											position++
-											goto l379
-										l380:
-											position, tokenIndex, depth = position379, tokenIndex379, depth379
+											goto l612
+										l613:
+											position, tokenIndex, depth = position612, tokenIndex612, depth612
 											if buffer[position] != rune('S') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l379:
+									l612:
 										break
 									case 'W', 'w':
 										{
-											position381, tokenIndex381, depth381 := position, tokenIndex, depth
+											position614, tokenIndex614, depth614 := position, tokenIndex, depth
 											if buffer[position] != rune('w') {
-												goto l382
+												goto l615
 											}
 											position++
-											goto l381
-										l382:
-											position, tokenIndex, depth = position381, tokenIndex381, depth381
+											goto l614
+										l615:
+											position, tokenIndex, depth = position614, tokenIndex614, depth614
 											if buffer[position] != rune('W') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l381:
+									l614:
 										{
-											position383, tokenIndex383, depth383 := position, tokenIndex, depth
+											position616, tokenIndex616, depth616 := position, tokenIndex, depth
 											if buffer[position] != rune('h') {
-												goto l384
+												goto l617
 											}
 											position++
-											goto l383
-										l384:
-											position, tokenIndex, depth = position383, tokenIndex383, depth383
+											goto l616
+										l617:
+											position, tokenIndex, depth = position616, tokenIndex616, depth616
 											if buffer[position] != rune('H') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l383:
+									l616:
 										{
-											position385, tokenIndex385, depth385 := position, tokenIndex, depth
+											position618, tokenIndex618, depth618 := position, tokenIndex, depth
 											if buffer[position] != rune('e') {
-												goto l386
+												goto l619
 											}
 											position++
-											goto l385
-										l386:
-											position, tokenIndex, depth = position385, tokenIndex385, depth385
+											goto l618
+										l619:
+											position, tokenIndex, depth = position618, tokenIndex618, depth618
 											if buffer[position] != rune('E') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l385:
+									l618:
 										{
-											position387, tokenIndex387, depth387 := position, tokenIndex, depth
+											position620, tokenIndex620, depth620 := position, tokenIndex, depth
 											if buffer[position] != rune('r') {
-												goto l388
+												goto l621
 											}
 											position++
-											goto l387
-										l388:
-											position, tokenIndex, depth = position387, tokenIndex387, depth387
+											goto l620
+										l621:
+											position, tokenIndex, depth = position620, tokenIndex620, depth620
 											if buffer[position] != rune('R') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l387:
+									l620:
 										{
-											position389, tokenIndex389, depth389 := position, tokenIndex, depth
+											position622, tokenIndex622, depth622 := position, tokenIndex, depth
 											if buffer[position] != rune('e') {
-												goto l390
+												goto l623
 											}
 											position++
-											goto l389
-										l390:
-											position, tokenIndex, depth = position389, tokenIndex389, depth389
+											goto l622
+										l623:
+											position, tokenIndex, depth = position622, tokenIndex622, depth622
 											if buffer[position] != rune('E') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l389:
+									l622:
 										break
 									case 'O', 'o':
 										{
-											position391, tokenIndex391, depth391 := position, tokenIndex, depth
+											position624, tokenIndex624, depth624 := position, tokenIndex, depth
 											if buffer[position] != rune('o') {
-												goto l392
+												goto l625
 											}
 											position++
-											goto l391
-										l392:
-											position, tokenIndex, depth = position391, tokenIndex391, depth391
+											goto l624
+										l625:
+											position, tokenIndex, depth = position624, tokenIndex624, depth624
 											if buffer[position] != rune('O') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l391:
+									l624:
 										{
-											position393, tokenIndex393, depth393 := position, tokenIndex, depth
+											position626, tokenIndex626, depth626 := position, tokenIndex, depth
 											if buffer[position] != rune('r') {
-												goto l394
+												goto l627
 											}
 											position++
-											goto l393
-										l394:
-											position, tokenIndex, depth = position393, tokenIndex393, depth393
+											goto l626
+										l627:
+											position, tokenIndex, depth = position626, tokenIndex626, depth626
 											if buffer[position] != rune('R') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l393:
+									l626:
 										break
 									case 'N', 'n':
 										{
-											position395, tokenIndex395, depth395 := position, tokenIndex, depth
+											position628, tokenIndex628, depth628 := position, tokenIndex, depth
 											if buffer[position] != rune('n') {
-												goto l396
+												goto l629
 											}
 											position++
-											goto l395
-										l396:
-											position, tokenIndex, depth = position395, tokenIndex395, depth395
+											goto l628
+										l629:
+											position, tokenIndex, depth = position628, tokenIndex628, depth628
 											if buffer[position] != rune('N') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l395:
+									l628:
 										{
-											position397, tokenIndex397, depth397 := position, tokenIndex, depth
+											position630, tokenIndex630, depth630 := position, tokenIndex, depth
 											if buffer[position] != rune('o') {
-												goto l398
+												goto l631
 											}
 											position++
-											goto l397
-										l398:
-											position, tokenIndex, depth = position397, tokenIndex397, depth397
+											goto l630
+										l631:
+											position, tokenIndex, depth = position630, tokenIndex630, depth630
 											if buffer[position] != rune('O') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l397:
+									l630:
 										{
-											position399, tokenIndex399, depth399 := position, tokenIndex, depth
+											position632, tokenIndex632, depth632 := position, tokenIndex, depth
 											if buffer[position] != rune('t') {
-												goto l400
+												goto l633
 											}
 											position++
-											goto l399
-										l400:
-											position, tokenIndex, depth = position399, tokenIndex399, depth399
+											goto l632
+										l633:
+											position, tokenIndex, depth = position632, tokenIndex632, depth632
 											if buffer[position] != rune('T') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l399:
+									l632:
 										break
 									case 'I', 'i':
 										{
-											position401, tokenIndex401, depth401 := position, tokenIndex, depth
+											position634, tokenIndex634, depth634 := position, tokenIndex, depth
 											if buffer[position] != rune('i') {
-												goto l402
+												goto l635
 											}
 											position++
-											goto l401
-										l402:
-											position, tokenIndex, depth = position401, tokenIndex401, depth401
+											goto l634
+										l635:
+											position, tokenIndex, depth = position634, tokenIndex634, depth634
 											if buffer[position] != rune('I') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l401:
+									l634:
 										{
-											position403, tokenIndex403, depth403 := position, tokenIndex, depth
+											position636, tokenIndex636, depth636 := position, tokenIndex, depth
 											if buffer[position] != rune('n') {
-												goto l404
+												goto l637
 											}
 											position++
-											goto l403
-										l404:
-											position, tokenIndex, depth = position403, tokenIndex403, depth403
+											goto l636
+										l637:
+											position, tokenIndex, depth = position636, tokenIndex636, depth636
 											if buffer[position] != rune('N') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l403:
+									l636:
 										break
 									case 'G', 'g':
 										{
-											position405, tokenIndex405, depth405 := position, tokenIndex, depth
+											position638, tokenIndex638, depth638 := position, tokenIndex, depth
 											if buffer[position] != rune('g') {
-												goto l406
+												goto l639
 											}
 											position++
-											goto l405
-										l406:
-											position, tokenIndex, depth = position405, tokenIndex405, depth405
+											goto l638
+										l639:
+											position, tokenIndex, depth = position638, tokenIndex638, depth638
 											if buffer[position] != rune('G') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l405:
+									l638:
 										{
-											position407, tokenIndex407, depth407 := position, tokenIndex, depth
+											position640, tokenIndex640, depth640 := position, tokenIndex, depth
 											if buffer[position] != rune('r') {
-												goto l408
+												goto l641
 											}
 											position++
-											goto l407
-										l408:
-											position, tokenIndex, depth = position407, tokenIndex407, depth407
+											goto l640
+										l641:
+											position, tokenIndex, depth = position640, tokenIndex640, depth640
 											if buffer[position] != rune('R') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l407:
+									l640:
 										{
-											position409, tokenIndex409, depth409 := position, tokenIndex, depth
+											position642, tokenIndex642, depth642 := position, tokenIndex, depth
 											if buffer[position] != rune('o') {
-												goto l410
+												goto l643
 											}
 											position++
-											goto l409
-										l410:
-											position, tokenIndex, depth = position409, tokenIndex409, depth409
+											goto l642
+										l643:
+											position, tokenIndex, depth = position642, tokenIndex642, depth642
 											if buffer[position] != rune('O') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l409:
+									l642:
 										{
-											position411, tokenIndex411, depth411 := position, tokenIndex, depth
+											position644, tokenIndex644, depth644 := position, tokenIndex, depth
 											if buffer[position] != rune('u') {
-												goto l412
+												goto l645
 											}
 											position++
-											goto l411
-										l412:
-											position, tokenIndex, depth = position411, tokenIndex411, depth411
+											goto l644
+										l645:
+											position, tokenIndex, depth = position644, tokenIndex644, depth644
 											if buffer[position] != rune('U') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l411:
+									l644:
 										{
-											position413, tokenIndex413, depth413 := position, tokenIndex, depth
+											position646, tokenIndex646, depth646 := position, tokenIndex, depth
 											if buffer[position] != rune('p') {
-												goto l414
+												goto l647
 											}
 											position++
-											goto l413
-										l414:
-											position, tokenIndex, depth = position413, tokenIndex413, depth413
+											goto l646
+										l647:
+											position, tokenIndex, depth = position646, tokenIndex646, depth646
 											if buffer[position] != rune('P') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l413:
+									l646:
 										break
 									case 'D', 'd':
 										{
-											position415, tokenIndex415, depth415 := position, tokenIndex, depth
+											position648, tokenIndex648, depth648 := position, tokenIndex, depth
 											if buffer[position] != rune('d') {
-												goto l416
+												goto l649
 											}
 											position++
-											goto l415
-										l416:
-											position, tokenIndex, depth = position415, tokenIndex415, depth415
+											goto l648
+										l649:
+											position, tokenIndex, depth = position648, tokenIndex648, depth648
 											if buffer[position] != rune('D') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l415:
+									l648:
 										{
-											position417, tokenIndex417, depth417 := position, tokenIndex, depth
+											position650, tokenIndex650, depth650 := position, tokenIndex, depth
 											if buffer[position] != rune('e') {
-												goto l418
+												goto l651
 											}
 											position++
-											goto l417
-										l418:
-											position, tokenIndex, depth = position417, tokenIndex417, depth417
+											goto l650
+										l651:
+											position, tokenIndex, depth = position650, tokenIndex650, depth650
 											if buffer[position] != rune('E') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l417:
+									l650:
 										{
-											position419, tokenIndex419, depth419 := position, tokenIndex, depth
+											position652, tokenIndex652, depth652 := position, tokenIndex, depth
 											if buffer[position] != rune('s') {
-												goto l420
+												goto l653
 											}
 											position++
-											goto l419
-										l420:
-											position, tokenIndex, depth = position419, tokenIndex419, depth419
+											goto l652
+										l653:
+											position, tokenIndex, depth = position652, tokenIndex652, depth652
 											if buffer[position] != rune('S') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l419:
+									l652:
 										{
-											position421, tokenIndex421, depth421 := position, tokenIndex, depth
+											position654, tokenIndex654, depth654 := position, tokenIndex, depth
 											if buffer[position] != rune('c') {
-												goto l422
+												goto l655
 											}
 											position++
-											goto l421
-										l422:
-											position, tokenIndex, depth = position421, tokenIndex421, depth421
+											goto l654
+										l655:
+											position, tokenIndex, depth = position654, tokenIndex654, depth654
 											if buffer[position] != rune('C') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l421:
+									l654:
 										{
-											position423, tokenIndex423, depth423 := position, tokenIndex, depth
+											position656, tokenIndex656, depth656 := position, tokenIndex, depth
 											if buffer[position] != rune('r') {
-												goto l424
+												goto l657
 											}
 											position++
-											goto l423
-										l424:
-											position, tokenIndex, depth = position423, tokenIndex423, depth423
+											goto l656
+										l657:
+											position, tokenIndex, depth = position656, tokenIndex656, depth656
 											if buffer[position] != rune('R') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l423:
+									l656:
 										{
-											position425, tokenIndex425, depth425 := position, tokenIndex, depth
+											position658, tokenIndex658, depth658 := position, tokenIndex, depth
 											if buffer[position] != rune('i') {
-												goto l426
+												goto l659
 											}
 											position++
-											goto l425
-										l426:
-											position, tokenIndex, depth = position425, tokenIndex425, depth425
+											goto l658
+										l659:
+											position, tokenIndex, depth = position658, tokenIndex658, depth658
 											if buffer[position] != rune('I') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l425:
+									l658:
 										{
-											position427, tokenIndex427, depth427 := position, tokenIndex, depth
+											position660, tokenIndex660, depth660 := position, tokenIndex, depth
 											if buffer[position] != rune('b') {
-												goto l428
+												goto l661
 											}
 											position++
-											goto l427
-										l428:
-											position, tokenIndex, depth = position427, tokenIndex427, depth427
+											goto l660
+										l661:
+											position, tokenIndex, depth = position660, tokenIndex660, depth660
 											if buffer[position] != rune('B') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l427:
+									l660:
 										{
-											position429, tokenIndex429, depth429 := position, tokenIndex, depth
+											position662, tokenIndex662, depth662 := position, tokenIndex, depth
 											if buffer[position] != rune('e') {
-												goto l430
+												goto l663
 											}
 											position++
-											goto l429
-										l430:
-											position, tokenIndex, depth = position429, tokenIndex429, depth429
+											goto l662
+										l663:
+											position, tokenIndex, depth = position662, tokenIndex662, depth662
 											if buffer[position] != rune('E') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l429:
+									l662:
 										break
 									case 'B', 'b':
 										{
-											position431, tokenIndex431, depth431 := position, tokenIndex, depth
+											position664, tokenIndex664, depth664 := position, tokenIndex, depth
 											if buffer[position] != rune('b') {
-												goto l432
+												goto l665
 											}
 											position++
-											goto l431
-										l432:
-											position, tokenIndex, depth = position431, tokenIndex431, depth431
+											goto l664
+										l665:
+											position, tokenIndex, depth = position664, tokenIndex664, depth664
 											if buffer[position] != rune('B') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l431:
+									l664:
 										{
-											position433, tokenIndex433, depth433 := position, tokenIndex, depth
+											position666, tokenIndex666, depth666 := position, tokenIndex, depth
 											if buffer[position] != rune('y') {
-												goto l434
+												goto l667
 											}
 											position++
-											goto l433
-										l434:
-											position, tokenIndex, depth = position433, tokenIndex433, depth433
+											goto l666
+										l667:
+											position, tokenIndex, depth = position666, tokenIndex666, depth666
 											if buffer[position] != rune('Y') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l433:
+									l666:
 										break
 									case 'A', 'a':
 										{
-											position435, tokenIndex435, depth435 := position, tokenIndex, depth
+											position668, tokenIndex668, depth668 := position, tokenIndex, depth
 											if buffer[position] != rune('a') {
-												goto l436
+												goto l669
 											}
 											position++
-											goto l435
-										l436:
-											position, tokenIndex, depth = position435, tokenIndex435, depth435
+											goto l668
+										l669:
+											position, tokenIndex, depth = position668, tokenIndex668, depth668
 											if buffer[position] != rune('A') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l435:
+									l668:
 										{
-											position437, tokenIndex437, depth437 := position, tokenIndex, depth
+											position670, tokenIndex670, depth670 := position, tokenIndex, depth
 											if buffer[position] != rune('s') {
-												goto l438
+												goto l671
 											}
 											position++
-											goto l437
-										l438:
-											position, tokenIndex, depth = position437, tokenIndex437, depth437
+											goto l670
+										l671:
+											position, tokenIndex, depth = position670, tokenIndex670, depth670
 											if buffer[position] != rune('S') {
-												goto l321
+												goto l545
 											}
 											position++
 										}
-									l437:
+									l670:
 										break
 									default:
 										if !_rules[rulePROPERTY_KEY]() {
-											goto l321
+											goto l545
 										}
 										break
 									}
 								}
 
 							}
-						l323:
+						l547:
 							depth--
-							add(ruleKEYWORD, position322)
+							add(ruleKEYWORD, position546)
 						}
 						if !_rules[ruleKEY]() {
-							goto l321
+							goto l545
 						}
-						goto l315
-					l321:
-						position, tokenIndex, depth = position321, tokenIndex321, depth321
+						goto l539
+					l545:
+						position, tokenIndex, depth = position545, tokenIndex545, depth545
 					}
 					if !_rules[ruleID_SEGMENT]() {
-						goto l315
+						goto l539
 					}
-				l439:
+				l672:
 					{
-						position440, tokenIndex440, depth440 := position, tokenIndex, depth
+						position673, tokenIndex673, depth673 := position, tokenIndex, depth
 						if buffer[position] != rune('.') {
-							goto l440
+							goto l673
 						}
 						position++
 						if !_rules[ruleID_SEGMENT]() {
-							goto l440
+							goto l673
 						}
-						goto l439
-					l440:
-						position, tokenIndex, depth = position440, tokenIndex440, depth440
+						goto l672
+					l673:
+						position, tokenIndex, depth = position673, tokenIndex673, depth673
 					}
 				}
-			l317:
+			l541:
 				depth--
-				add(ruleIDENTIFIER, position316)
+				add(ruleIDENTIFIER, position540)
 			}
 			return true
-		l315:
-			position, tokenIndex, depth = position315, tokenIndex315, depth315
+		l539:
+			position, tokenIndex, depth = position539, tokenIndex539, depth539
 			return false
 		},
-		/* 30 TIMESTAMP <- <((_ <(NUMBER ([a-z] / [A-Z])*)>) / (_ STRING) / (_ <(('n' / 'N') ('o' / 'O') ('w' / 'W'))>))> */
+		/* 36 TIMESTAMP <- <((_ <(NUMBER ([a-z] / [A-Z])*)>) / (_ STRING) / (_ <(('n' / 'N') ('o' / 'O') ('w' / 'W'))>))> */
 		nil,
-		/* 31 ID_SEGMENT <- <(_ ID_START ID_CONT*)> */
+		/* 37 ID_SEGMENT <- <(_ ID_START ID_CONT*)> */
 		func() bool {
-			position442, tokenIndex442, depth442 := position, tokenIndex, depth
+			position675, tokenIndex675, depth675 := position, tokenIndex, depth
 			{
-				position443 := position
+				position676 := position
 				depth++
 				if !_rules[rule_]() {
-					goto l442
+					goto l675
 				}
 				if !_rules[ruleID_START]() {
-					goto l442
+					goto l675
 				}
-			l444:
+			l677:
 				{
-					position445, tokenIndex445, depth445 := position, tokenIndex, depth
+					position678, tokenIndex678, depth678 := position, tokenIndex, depth
 					if !_rules[ruleID_CONT]() {
-						goto l445
+						goto l678
 					}
-					goto l444
-				l445:
-					position, tokenIndex, depth = position445, tokenIndex445, depth445
+					goto l677
+				l678:
+					position, tokenIndex, depth = position678, tokenIndex678, depth678
 				}
 				depth--
-				add(ruleID_SEGMENT, position443)
+				add(ruleID_SEGMENT, position676)
 			}
 			return true
-		l442:
-			position, tokenIndex, depth = position442, tokenIndex442, depth442
+		l675:
+			position, tokenIndex, depth = position675, tokenIndex675, depth675
 			return false
 		},
-		/* 32 ID_START <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
+		/* 38 ID_START <- <((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))> */
 		func() bool {
-			position446, tokenIndex446, depth446 := position, tokenIndex, depth
+			position679, tokenIndex679, depth679 := position, tokenIndex, depth
 			{
-				position447 := position
+				position680 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '_':
 						if buffer[position] != rune('_') {
-							goto l446
+							goto l679
 						}
 						position++
 						break
 					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l446
+							goto l679
 						}
 						position++
 						break
 					default:
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l446
+							goto l679
 						}
 						position++
 						break
@@ -4056,938 +5861,961 @@ func (p *Parser) Init() {
 				}
 
 				depth--
-				add(ruleID_START, position447)
+				add(ruleID_START, position680)
 			}
 			return true
-		l446:
-			position, tokenIndex, depth = position446, tokenIndex446, depth446
+		l679:
+			position, tokenIndex, depth = position679, tokenIndex679, depth679
 			return false
 		},
-		/* 33 ID_CONT <- <(ID_START / [0-9])> */
+		/* 39 ID_CONT <- <(ID_START / [0-9])> */
 		func() bool {
-			position449, tokenIndex449, depth449 := position, tokenIndex, depth
+			position682, tokenIndex682, depth682 := position, tokenIndex, depth
 			{
-				position450 := position
+				position683 := position
 				depth++
 				{
-					position451, tokenIndex451, depth451 := position, tokenIndex, depth
+					position684, tokenIndex684, depth684 := position, tokenIndex, depth
 					if !_rules[ruleID_START]() {
-						goto l452
+						goto l685
 					}
-					goto l451
-				l452:
-					position, tokenIndex, depth = position451, tokenIndex451, depth451
+					goto l684
+				l685:
+					position, tokenIndex, depth = position684, tokenIndex684, depth684
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l449
+						goto l682
 					}
 					position++
 				}
-			l451:
+			l684:
 				depth--
-				add(ruleID_CONT, position450)
+				add(ruleID_CONT, position683)
 			}
 			return true
-		l449:
-			position, tokenIndex, depth = position449, tokenIndex449, depth449
+		l682:
+			position, tokenIndex, depth = position682, tokenIndex682, depth682
 			return false
 		},
-		/* 34 PROPERTY_KEY <- <(((&('S' | 's') (<(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E'))> KEY _ (('b' / 'B') ('y' / 'Y')))) | (&('R' | 'r') <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('o' / 'O') ('l' / 'L') ('u' / 'U') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N'))>) | (&('T' | 't') <(('t' / 'T') ('o' / 'O'))>) | (&('F' | 'f') <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M'))>)) KEY)> */
+		/* 40 PROPERTY_KEY <- <(((&('S' | 's') (<(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E'))> KEY _ (('b' / 'B') ('y' / 'Y')))) | (&('R' | 'r') <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('o' / 'O') ('l' / 'L') ('u' / 'U') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N'))>) | (&('T' | 't') <(('t' / 'T') ('o' / 'O'))>) | (&('F' | 'f') <(('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M'))>)) KEY)> */
 		func() bool {
-			position453, tokenIndex453, depth453 := position, tokenIndex, depth
+			position686, tokenIndex686, depth686 := position, tokenIndex, depth
 			{
-				position454 := position
+				position687 := position
 				depth++
 				{
 					switch buffer[position] {
 					case 'S', 's':
 						{
-							position456 := position
+							position689 := position
 							depth++
 							{
-								position457, tokenIndex457, depth457 := position, tokenIndex, depth
+								position690, tokenIndex690, depth690 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l458
+									goto l691
 								}
 								position++
-								goto l457
-							l458:
-								position, tokenIndex, depth = position457, tokenIndex457, depth457
+								goto l690
+							l691:
+								position, tokenIndex, depth = position690, tokenIndex690, depth690
 								if buffer[position] != rune('S') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l457:
+						l690:
 							{
-								position459, tokenIndex459, depth459 := position, tokenIndex, depth
+								position692, tokenIndex692, depth692 := position, tokenIndex, depth
 								if buffer[position] != rune('a') {
-									goto l460
+									goto l693
 								}
 								position++
-								goto l459
-							l460:
-								position, tokenIndex, depth = position459, tokenIndex459, depth459
+								goto l692
+							l693:
+								position, tokenIndex, depth = position692, tokenIndex692, depth692
 								if buffer[position] != rune('A') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l459:
+						l692:
 							{
-								position461, tokenIndex461, depth461 := position, tokenIndex, depth
+								position694, tokenIndex694, depth694 := position, tokenIndex, depth
 								if buffer[position] != rune('m') {
-									goto l462
+									goto l695
 								}
 								position++
-								goto l461
-							l462:
-								position, tokenIndex, depth = position461, tokenIndex461, depth461
+								goto l694
+							l695:
+								position, tokenIndex, depth = position694, tokenIndex694, depth694
 								if buffer[position] != rune('M') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l461:
+						l694:
 							{
-								position463, tokenIndex463, depth463 := position, tokenIndex, depth
+								position696, tokenIndex696, depth696 := position, tokenIndex, depth
 								if buffer[position] != rune('p') {
-									goto l464
+									goto l697
 								}
 								position++
-								goto l463
-							l464:
-								position, tokenIndex, depth = position463, tokenIndex463, depth463
+								goto l696
+							l697:
+								position, tokenIndex, depth = position696, tokenIndex696, depth696
 								if buffer[position] != rune('P') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l463:
+						l696:
 							{
-								position465, tokenIndex465, depth465 := position, tokenIndex, depth
+								position698, tokenIndex698, depth698 := position, tokenIndex, depth
 								if buffer[position] != rune('l') {
-									goto l466
+									goto l699
 								}
 								position++
-								goto l465
-							l466:
-								position, tokenIndex, depth = position465, tokenIndex465, depth465
+								goto l698
+							l699:
+								position, tokenIndex, depth = position698, tokenIndex698, depth698
 								if buffer[position] != rune('L') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l465:
+						l698:
 							{
-								position467, tokenIndex467, depth467 := position, tokenIndex, depth
+								position700, tokenIndex700, depth700 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l468
+									goto l701
 								}
 								position++
-								goto l467
-							l468:
-								position, tokenIndex, depth = position467, tokenIndex467, depth467
+								goto l700
+							l701:
+								position, tokenIndex, depth = position700, tokenIndex700, depth700
 								if buffer[position] != rune('E') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l467:
+						l700:
 							depth--
-							add(rulePegText, position456)
+							add(rulePegText, position689)
 						}
 						if !_rules[ruleKEY]() {
-							goto l453
+							goto l686
 						}
 						if !_rules[rule_]() {
-							goto l453
+							goto l686
 						}
 						{
-							position469, tokenIndex469, depth469 := position, tokenIndex, depth
+							position702, tokenIndex702, depth702 := position, tokenIndex, depth
 							if buffer[position] != rune('b') {
-								goto l470
+								goto l703
 							}
 							position++
-							goto l469
-						l470:
-							position, tokenIndex, depth = position469, tokenIndex469, depth469
+							goto l702
+						l703:
+							position, tokenIndex, depth = position702, tokenIndex702, depth702
 							if buffer[position] != rune('B') {
-								goto l453
+								goto l686
 							}
 							position++
 						}
-					l469:
+					l702:
 						{
-							position471, tokenIndex471, depth471 := position, tokenIndex, depth
+							position704, tokenIndex704, depth704 := position, tokenIndex, depth
 							if buffer[position] != rune('y') {
-								goto l472
+								goto l705
 							}
 							position++
-							goto l471
-						l472:
-							position, tokenIndex, depth = position471, tokenIndex471, depth471
+							goto l704
+						l705:
+							position, tokenIndex, depth = position704, tokenIndex704, depth704
 							if buffer[position] != rune('Y') {
-								goto l453
+								goto l686
 							}
 							position++
 						}
-					l471:
+					l704:
 						break
 					case 'R', 'r':
 						{
-							position473 := position
+							position706 := position
 							depth++
 							{
-								position474, tokenIndex474, depth474 := position, tokenIndex, depth
+								position707, tokenIndex707, depth707 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l475
+									goto l708
 								}
 								position++
-								goto l474
-							l475:
-								position, tokenIndex, depth = position474, tokenIndex474, depth474
+								goto l707
+							l708:
+								position, tokenIndex, depth = position707, tokenIndex707, depth707
 								if buffer[position] != rune('R') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l474:
+						l707:
 							{
-								position476, tokenIndex476, depth476 := position, tokenIndex, depth
+								position709, tokenIndex709, depth709 := position, tokenIndex, depth
 								if buffer[position] != rune('e') {
-									goto l477
+									goto l710
 								}
 								position++
-								goto l476
-							l477:
-								position, tokenIndex, depth = position476, tokenIndex476, depth476
+								goto l709
+							l710:
+								position, tokenIndex, depth = position709, tokenIndex709, depth709
 								if buffer[position] != rune('E') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l476:
+						l709:
 							{
-								position478, tokenIndex478, depth478 := position, tokenIndex, depth
+								position711, tokenIndex711, depth711 := position, tokenIndex, depth
 								if buffer[position] != rune('s') {
-									goto l479
+									goto l712
 								}
 								position++
-								goto l478
-							l479:
-								position, tokenIndex, depth = position478, tokenIndex478, depth478
+								goto l711
+							l712:
+								position, tokenIndex, depth = position711, tokenIndex711, depth711
 								if buffer[position] != rune('S') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l478:
+						l711:
 							{
-								position480, tokenIndex480, depth480 := position, tokenIndex, depth
+								position713, tokenIndex713, depth713 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l481
+									goto l714
 								}
 								position++
-								goto l480
-							l481:
-								position, tokenIndex, depth = position480, tokenIndex480, depth480
+								goto l713
+							l714:
+								position, tokenIndex, depth = position713, tokenIndex713, depth713
 								if buffer[position] != rune('O') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l480:
+						l713:
 							{
-								position482, tokenIndex482, depth482 := position, tokenIndex, depth
+								position715, tokenIndex715, depth715 := position, tokenIndex, depth
 								if buffer[position] != rune('l') {
-									goto l483
+									goto l716
 								}
 								position++
-								goto l482
-							l483:
-								position, tokenIndex, depth = position482, tokenIndex482, depth482
+								goto l715
+							l716:
+								position, tokenIndex, depth = position715, tokenIndex715, depth715
 								if buffer[position] != rune('L') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l482:
+						l715:
 							{
-								position484, tokenIndex484, depth484 := position, tokenIndex, depth
+								position717, tokenIndex717, depth717 := position, tokenIndex, depth
 								if buffer[position] != rune('u') {
-									goto l485
+									goto l718
 								}
 								position++
-								goto l484
-							l485:
-								position, tokenIndex, depth = position484, tokenIndex484, depth484
+								goto l717
+							l718:
+								position, tokenIndex, depth = position717, tokenIndex717, depth717
 								if buffer[position] != rune('U') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l484:
+						l717:
 							{
-								position486, tokenIndex486, depth486 := position, tokenIndex, depth
+								position719, tokenIndex719, depth719 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l487
+									goto l720
 								}
 								position++
-								goto l486
-							l487:
-								position, tokenIndex, depth = position486, tokenIndex486, depth486
+								goto l719
+							l720:
+								position, tokenIndex, depth = position719, tokenIndex719, depth719
 								if buffer[position] != rune('T') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l486:
+						l719:
 							{
-								position488, tokenIndex488, depth488 := position, tokenIndex, depth
+								position721, tokenIndex721, depth721 := position, tokenIndex, depth
 								if buffer[position] != rune('i') {
-									goto l489
+									goto l722
 								}
 								position++
-								goto l488
-							l489:
-								position, tokenIndex, depth = position488, tokenIndex488, depth488
+								goto l721
+							l722:
+								position, tokenIndex, depth = position721, tokenIndex721, depth721
 								if buffer[position] != rune('I') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l488:
+						l721:
 							{
-								position490, tokenIndex490, depth490 := position, tokenIndex, depth
+								position723, tokenIndex723, depth723 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l491
+									goto l724
 								}
 								position++
-								goto l490
-							l491:
-								position, tokenIndex, depth = position490, tokenIndex490, depth490
+								goto l723
+							l724:
+								position, tokenIndex, depth = position723, tokenIndex723, depth723
 								if buffer[position] != rune('O') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l490:
+						l723:
 							{
-								position492, tokenIndex492, depth492 := position, tokenIndex, depth
+								position725, tokenIndex725, depth725 := position, tokenIndex, depth
 								if buffer[position] != rune('n') {
-									goto l493
+									goto l726
 								}
 								position++
-								goto l492
-							l493:
-								position, tokenIndex, depth = position492, tokenIndex492, depth492
+								goto l725
+							l726:
+								position, tokenIndex, depth = position725, tokenIndex725, depth725
 								if buffer[position] != rune('N') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l492:
+						l725:
 							depth--
-							add(rulePegText, position473)
+							add(rulePegText, position706)
 						}
 						break
 					case 'T', 't':
 						{
-							position494 := position
+							position727 := position
 							depth++
 							{
-								position495, tokenIndex495, depth495 := position, tokenIndex, depth
+								position728, tokenIndex728, depth728 := position, tokenIndex, depth
 								if buffer[position] != rune('t') {
-									goto l496
+									goto l729
 								}
 								position++
-								goto l495
-							l496:
-								position, tokenIndex, depth = position495, tokenIndex495, depth495
+								goto l728
+							l729:
+								position, tokenIndex, depth = position728, tokenIndex728, depth728
 								if buffer[position] != rune('T') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l495:
+						l728:
 							{
-								position497, tokenIndex497, depth497 := position, tokenIndex, depth
+								position730, tokenIndex730, depth730 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l498
+									goto l731
 								}
 								position++
-								goto l497
-							l498:
-								position, tokenIndex, depth = position497, tokenIndex497, depth497
+								goto l730
+							l731:
+								position, tokenIndex, depth = position730, tokenIndex730, depth730
 								if buffer[position] != rune('O') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l497:
+						l730:
 							depth--
-							add(rulePegText, position494)
+							add(rulePegText, position727)
 						}
 						break
 					default:
 						{
-							position499 := position
+							position732 := position
 							depth++
 							{
-								position500, tokenIndex500, depth500 := position, tokenIndex, depth
+								position733, tokenIndex733, depth733 := position, tokenIndex, depth
 								if buffer[position] != rune('f') {
-									goto l501
+									goto l734
 								}
 								position++
-								goto l500
-							l501:
-								position, tokenIndex, depth = position500, tokenIndex500, depth500
+								goto l733
+							l734:
+								position, tokenIndex, depth = position733, tokenIndex733, depth733
 								if buffer[position] != rune('F') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l500:
+						l733:
 							{
-								position502, tokenIndex502, depth502 := position, tokenIndex, depth
+								position735, tokenIndex735, depth735 := position, tokenIndex, depth
 								if buffer[position] != rune('r') {
-									goto l503
+									goto l736
 								}
 								position++
-								goto l502
-							l503:
-								position, tokenIndex, depth = position502, tokenIndex502, depth502
+								goto l735
+							l736:
+								position, tokenIndex, depth = position735, tokenIndex735, depth735
 								if buffer[position] != rune('R') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l502:
+						l735:
 							{
-								position504, tokenIndex504, depth504 := position, tokenIndex, depth
+								position737, tokenIndex737, depth737 := position, tokenIndex, depth
 								if buffer[position] != rune('o') {
-									goto l505
+									goto l738
 								}
 								position++
-								goto l504
-							l505:
-								position, tokenIndex, depth = position504, tokenIndex504, depth504
+								goto l737
+							l738:
+								position, tokenIndex, depth = position737, tokenIndex737, depth737
 								if buffer[position] != rune('O') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l504:
+						l737:
 							{
-								position506, tokenIndex506, depth506 := position, tokenIndex, depth
+								position739, tokenIndex739, depth739 := position, tokenIndex, depth
 								if buffer[position] != rune('m') {
-									goto l507
+									goto l740
 								}
 								position++
-								goto l506
-							l507:
-								position, tokenIndex, depth = position506, tokenIndex506, depth506
+								goto l739
+							l740:
+								position, tokenIndex, depth = position739, tokenIndex739, depth739
 								if buffer[position] != rune('M') {
-									goto l453
+									goto l686
 								}
 								position++
 							}
-						l506:
+						l739:
 							depth--
-							add(rulePegText, position499)
+							add(rulePegText, position732)
 						}
 						break
 					}
 				}
 
 				if !_rules[ruleKEY]() {
-					goto l453
+					goto l686
 				}
 				depth--
-				add(rulePROPERTY_KEY, position454)
+				add(rulePROPERTY_KEY, position687)
 			}
 			return true
-		l453:
-			position, tokenIndex, depth = position453, tokenIndex453, depth453
+		l686:
+			position, tokenIndex, depth = position686, tokenIndex686, depth686
 			return false
 		},
-		/* 35 PROPERTY_VALUE <- <TIMESTAMP> */
+		/* 41 PROPERTY_VALUE <- <TIMESTAMP> */
+		nil,
+		/* 42 KEYWORD <- <((('a' / 'A') ('l' / 'L') ('l' / 'L')) / (('a' / 'A') ('n' / 'N') ('d' / 'D')) / (('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L')) / (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) / (('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T')) / ((&('M' | 'm') (('m' / 'M') ('e' / 'E') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('c' / 'C') ('s' / 'S'))) | (&('W' | 'w') (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E'))) | (&('O' | 'o') (('o' / 'O') ('r' / 'R'))) | (&('N' | 'n') (('n' / 'N') ('o' / 'O') ('t' / 'T'))) | (&('I' | 'i') (('i' / 'I') ('n' / 'N'))) | (&('G' | 'g') (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P'))) | (&('D' | 'd') (('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E'))) | (&('B' | 'b') (('b' / 'B') ('y' / 'Y'))) | (&('A' | 'a') (('a' / 'A') ('s' / 'S'))) | (&('F' | 'R' | 'S' | 'T' | 'f' | 'r' | 's' | 't') PROPERTY_KEY)))> */
+		nil,
+		/* 43 OP_PIPE <- <'|'> */
+		nil,
+		/* 44 OP_ADD <- <'+'> */
+		nil,
+		/* 45 OP_SUB <- <'-'> */
 		nil,
-		/* 36 KEYWORD <- <((('a' / 'A') ('l' / 'L') ('l' / 'L')) / (('a' / 'A') ('n' / 'N') ('d' / 'D')) / (('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S')) / (('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T')) / ((&('M' | 'm') (('m' / 'M') ('e' / 'E') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('c' / 'C') ('s' / 'S'))) | (&('W' | 'w') (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E'))) | (&('O' | 'o') (('o' / 'O') ('r' / 'R'))) | (&('N' | 'n') (('n' / 'N') ('o' / 'O') ('t' / 'T'))) | (&('I' | 'i') (('i' / 'I') ('n' / 'N'))) | (&('G' | 'g') (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P'))) | (&('D' | 'd') (('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E'))) | (&('B' | 'b') (('b' / 'B') ('y' / 'Y'))) | (&('A' | 'a') (('a' / 'A') ('s' / 'S'))) | (&('F' | 'R' | 'S' | 'T' | 'f' | 'r' | 's' | 't') PROPERTY_KEY)))> */
+		/* 46 OP_MULT <- <'*'> */
 		nil,
-		/* 37 OP_PIPE <- <'|'> */
+		/* 47 OP_DIV <- <'/'> */
 		nil,
-		/* 38 OP_ADD <- <'+'> */
+		/* 48 OP_GE <- <('>' '=')> */
 		nil,
-		/* 39 OP_SUB <- <'-'> */
+		/* 49 OP_LE <- <('<' '=')> */
 		nil,
-		/* 40 OP_MULT <- <'*'> */
+		/* 50 OP_EQ <- <('=' '=')> */
 		nil,
-		/* 41 OP_DIV <- <'/'> */
+		/* 51 OP_NE <- <('!' '=')> */
 		nil,
-		/* 42 OP_AND <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') KEY)> */
+		/* 52 OP_GT <- <'>'> */
 		nil,
-		/* 43 OP_OR <- <(('o' / 'O') ('r' / 'R') KEY)> */
+		/* 53 OP_LT <- <'<'> */
 		nil,
-		/* 44 OP_NOT <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') KEY)> */
+		/* 54 OP_BOOL <- <(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L') KEY)> */
 		nil,
-		/* 45 QUOTE_SINGLE <- <'\''> */
+		/* 55 OP_AND <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') KEY)> */
+		nil,
+		/* 56 OP_OR <- <(('o' / 'O') ('r' / 'R') KEY)> */
+		nil,
+		/* 57 OP_NOT <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') KEY)> */
+		nil,
+		/* 58 QUOTE_SINGLE <- <'\''> */
 		func() bool {
-			position518, tokenIndex518, depth518 := position, tokenIndex, depth
+			position758, tokenIndex758, depth758 := position, tokenIndex, depth
 			{
-				position519 := position
+				position759 := position
 				depth++
 				if buffer[position] != rune('\'') {
-					goto l518
+					goto l758
 				}
 				position++
 				depth--
-				add(ruleQUOTE_SINGLE, position519)
+				add(ruleQUOTE_SINGLE, position759)
 			}
 			return true
-		l518:
-			position, tokenIndex, depth = position518, tokenIndex518, depth518
+		l758:
+			position, tokenIndex, depth = position758, tokenIndex758, depth758
 			return false
 		},
-		/* 46 QUOTE_DOUBLE <- <'"'> */
+		/* 59 QUOTE_DOUBLE <- <'"'> */
 		func() bool {
-			position520, tokenIndex520, depth520 := position, tokenIndex, depth
+			position760, tokenIndex760, depth760 := position, tokenIndex, depth
 			{
-				position521 := position
+				position761 := position
 				depth++
 				if buffer[position] != rune('"') {
-					goto l520
+					goto l760
 				}
 				position++
 				depth--
-				add(ruleQUOTE_DOUBLE, position521)
+				add(ruleQUOTE_DOUBLE, position761)
 			}
 			return true
-		l520:
-			position, tokenIndex, depth = position520, tokenIndex520, depth520
+		l760:
+			position, tokenIndex, depth = position760, tokenIndex760, depth760
 			return false
 		},
-		/* 47 STRING <- <((QUOTE_SINGLE <(!QUOTE_SINGLE CHAR)*> QUOTE_SINGLE) / (QUOTE_DOUBLE <(!QUOTE_DOUBLE CHAR)*> QUOTE_DOUBLE))> */
+		/* 60 STRING <- <((QUOTE_SINGLE <(!QUOTE_SINGLE CHAR)*> QUOTE_SINGLE) / (QUOTE_DOUBLE <(!QUOTE_DOUBLE CHAR)*> QUOTE_DOUBLE))> */
 		func() bool {
-			position522, tokenIndex522, depth522 := position, tokenIndex, depth
+			position762, tokenIndex762, depth762 := position, tokenIndex, depth
 			{
-				position523 := position
+				position763 := position
 				depth++
 				{
-					position524, tokenIndex524, depth524 := position, tokenIndex, depth
+					position764, tokenIndex764, depth764 := position, tokenIndex, depth
 					if !_rules[ruleQUOTE_SINGLE]() {
-						goto l525
+						goto l765
 					}
 					{
-						position526 := position
+						position766 := position
 						depth++
-					l527:
+					l767:
 						{
-							position528, tokenIndex528, depth528 := position, tokenIndex, depth
+							position768, tokenIndex768, depth768 := position, tokenIndex, depth
 							{
-								position529, tokenIndex529, depth529 := position, tokenIndex, depth
+								position769, tokenIndex769, depth769 := position, tokenIndex, depth
 								if !_rules[ruleQUOTE_SINGLE]() {
-									goto l529
+									goto l769
 								}
-								goto l528
-							l529:
-								position, tokenIndex, depth = position529, tokenIndex529, depth529
+								goto l768
+							l769:
+								position, tokenIndex, depth = position769, tokenIndex769, depth769
 							}
 							if !_rules[ruleCHAR]() {
-								goto l528
+								goto l768
 							}
-							goto l527
-						l528:
-							position, tokenIndex, depth = position528, tokenIndex528, depth528
+							goto l767
+						l768:
+							position, tokenIndex, depth = position768, tokenIndex768, depth768
 						}
 						depth--
-						add(rulePegText, position526)
+						add(rulePegText, position766)
 					}
 					if !_rules[ruleQUOTE_SINGLE]() {
-						goto l525
+						goto l765
 					}
-					goto l524
-				l525:
-					position, tokenIndex, depth = position524, tokenIndex524, depth524
+					goto l764
+				l765:
+					position, tokenIndex, depth = position764, tokenIndex764, depth764
 					if !_rules[ruleQUOTE_DOUBLE]() {
-						goto l522
+						goto l762
 					}
 					{
-						position530 := position
+						position770 := position
 						depth++
-					l531:
+					l771:
 						{
-							position532, tokenIndex532, depth532 := position, tokenIndex, depth
+							position772, tokenIndex772, depth772 := position, tokenIndex, depth
 							{
-								position533, tokenIndex533, depth533 := position, tokenIndex, depth
+								position773, tokenIndex773, depth773 := position, tokenIndex, depth
 								if !_rules[ruleQUOTE_DOUBLE]() {
-									goto l533
+									goto l773
 								}
-								goto l532
-							l533:
-								position, tokenIndex, depth = position533, tokenIndex533, depth533
+								goto l772
+							l773:
+								position, tokenIndex, depth = position773, tokenIndex773, depth773
 							}
 							if !_rules[ruleCHAR]() {
-								goto l532
+								goto l772
 							}
-							goto l531
-						l532:
-							position, tokenIndex, depth = position532, tokenIndex532, depth532
+							goto l771
+						l772:
+							position, tokenIndex, depth = position772, tokenIndex772, depth772
 						}
 						depth--
-						add(rulePegText, position530)
+						add(rulePegText, position770)
 					}
 					if !_rules[ruleQUOTE_DOUBLE]() {
-						goto l522
+						goto l762
 					}
 				}
-			l524:
+			l764:
 				depth--
-				add(ruleSTRING, position523)
+				add(ruleSTRING, position763)
 			}
 			return true
-		l522:
-			position, tokenIndex, depth = position522, tokenIndex522, depth522
+		l762:
+			position, tokenIndex, depth = position762, tokenIndex762, depth762
 			return false
 		},
-		/* 48 CHAR <- <(('\\' ((&('"') QUOTE_DOUBLE) | (&('\'') QUOTE_SINGLE) | (&('\\' | '`') ESCAPE_CLASS))) / (!ESCAPE_CLASS .))> */
+		/* 61 CHAR <- <(('\\' ((&('"') QUOTE_DOUBLE) | (&('\'') QUOTE_SINGLE) | (&('\\' | '`') ESCAPE_CLASS))) / (!ESCAPE_CLASS .))> */
 		func() bool {
-			position534, tokenIndex534, depth534 := position, tokenIndex, depth
+			position774, tokenIndex774, depth774 := position, tokenIndex, depth
 			{
-				position535 := position
+				position775 := position
 				depth++
 				{
-					position536, tokenIndex536, depth536 := position, tokenIndex, depth
+					position776, tokenIndex776, depth776 := position, tokenIndex, depth
 					if buffer[position] != rune('\\') {
-						goto l537
+						goto l777
 					}
 					position++
 					{
 						switch buffer[position] {
 						case '"':
 							if !_rules[ruleQUOTE_DOUBLE]() {
-								goto l537
+								goto l777
 							}
 							break
 						case '\'':
 							if !_rules[ruleQUOTE_SINGLE]() {
-								goto l537
+								goto l777
 							}
 							break
 						default:
 							if !_rules[ruleESCAPE_CLASS]() {
-								goto l537
+								goto l777
 							}
 							break
 						}
 					}
 
-					goto l536
-				l537:
-					position, tokenIndex, depth = position536, tokenIndex536, depth536
+					goto l776
+				l777:
+					position, tokenIndex, depth = position776, tokenIndex776, depth776
 					{
-						position539, tokenIndex539, depth539 := position, tokenIndex, depth
+						position779, tokenIndex779, depth779 := position, tokenIndex, depth
 						if !_rules[ruleESCAPE_CLASS]() {
-							goto l539
+							goto l779
 						}
-						goto l534
-					l539:
-						position, tokenIndex, depth = position539, tokenIndex539, depth539
+						goto l774
+					l779:
+						position, tokenIndex, depth = position779, tokenIndex779, depth779
 					}
 					if !matchDot() {
-						goto l534
+						goto l774
 					}
 				}
-			l536:
+			l776:
 				depth--
-				add(ruleCHAR, position535)
+				add(ruleCHAR, position775)
 			}
 			return true
-		l534:
-			position, tokenIndex, depth = position534, tokenIndex534, depth534
+		l774:
+			position, tokenIndex, depth = position774, tokenIndex774, depth774
 			return false
 		},
-		/* 49 ESCAPE_CLASS <- <('`' / '\\')> */
+		/* 62 ESCAPE_CLASS <- <('`' / '\\')> */
 		func() bool {
-			position540, tokenIndex540, depth540 := position, tokenIndex, depth
+			position780, tokenIndex780, depth780 := position, tokenIndex, depth
 			{
-				position541 := position
+				position781 := position
 				depth++
 				{
-					position542, tokenIndex542, depth542 := position, tokenIndex, depth
+					position782, tokenIndex782, depth782 := position, tokenIndex, depth
 					if buffer[position] != rune('`') {
-						goto l543
+						goto l783
 					}
 					position++
-					goto l542
-				l543:
-					position, tokenIndex, depth = position542, tokenIndex542, depth542
+					goto l782
+				l783:
+					position, tokenIndex, depth = position782, tokenIndex782, depth782
 					if buffer[position] != rune('\\') {
-						goto l540
+						goto l780
 					}
 					position++
 				}
-			l542:
+			l782:
 				depth--
-				add(ruleESCAPE_CLASS, position541)
+				add(ruleESCAPE_CLASS, position781)
 			}
 			return true
-		l540:
-			position, tokenIndex, depth = position540, tokenIndex540, depth540
+		l780:
+			position, tokenIndex, depth = position780, tokenIndex780, depth780
 			return false
 		},
-		/* 50 NUMBER <- <(NUMBER_INTEGER NUMBER_FRACTION? NUMBER_EXP?)> */
+		/* 63 NUMBER <- <(NUMBER_INTEGER NUMBER_FRACTION? NUMBER_EXP?)> */
 		func() bool {
-			position544, tokenIndex544, depth544 := position, tokenIndex, depth
+			position784, tokenIndex784, depth784 := position, tokenIndex, depth
 			{
-				position545 := position
+				position785 := position
 				depth++
 				{
-					position546 := position
+					position786 := position
 					depth++
 					{
-						position547, tokenIndex547, depth547 := position, tokenIndex, depth
+						position787, tokenIndex787, depth787 := position, tokenIndex, depth
 						if buffer[position] != rune('-') {
-							goto l547
+							goto l787
 						}
 						position++
-						goto l548
-					l547:
-						position, tokenIndex, depth = position547, tokenIndex547, depth547
+						goto l788
+					l787:
+						position, tokenIndex, depth = position787, tokenIndex787, depth787
 					}
-				l548:
-					{
-						position549 := position
-						depth++
-						{
-							position550, tokenIndex550, depth550 := position, tokenIndex, depth
-							if buffer[position] != rune('0') {
-								goto l551
-							}
-							position++
-							goto l550
-						l551:
-							position, tokenIndex, depth = position550, tokenIndex550, depth550
-							if c := buffer[position]; c < rune('1') || c > rune('9') {
-								goto l544
-							}
-							position++
-						l552:
-							{
-								position553, tokenIndex553, depth553 := position, tokenIndex, depth
-								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l553
-								}
-								position++
-								goto l552
-							l553:
-								position, tokenIndex, depth = position553, tokenIndex553, depth553
-							}
-						}
-					l550:
-						depth--
-						add(ruleNUMBER_NATURAL, position549)
+				l788:
+					if !_rules[ruleNUMBER_NATURAL]() {
+						goto l784
 					}
 					depth--
-					add(ruleNUMBER_INTEGER, position546)
+					add(ruleNUMBER_INTEGER, position786)
 				}
 				{
-					position554, tokenIndex554, depth554 := position, tokenIndex, depth
+					position789, tokenIndex789, depth789 := position, tokenIndex, depth
 					{
-						position556 := position
+						position791 := position
 						depth++
 						if buffer[position] != rune('.') {
-							goto l554
+							goto l789
 						}
 						position++
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l554
+							goto l789
 						}
 						position++
-					l557:
+					l792:
 						{
-							position558, tokenIndex558, depth558 := position, tokenIndex, depth
+							position793, tokenIndex793, depth793 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l558
+								goto l793
 							}
 							position++
-							goto l557
-						l558:
-							position, tokenIndex, depth = position558, tokenIndex558, depth558
+							goto l792
+						l793:
+							position, tokenIndex, depth = position793, tokenIndex793, depth793
 						}
 						depth--
-						add(ruleNUMBER_FRACTION, position556)
+						add(ruleNUMBER_FRACTION, position791)
 					}
-					goto l555
-				l554:
-					position, tokenIndex, depth = position554, tokenIndex554, depth554
+					goto l790
+				l789:
+					position, tokenIndex, depth = position789, tokenIndex789, depth789
 				}
-			l555:
+			l790:
 				{
-					position559, tokenIndex559, depth559 := position, tokenIndex, depth
+					position794, tokenIndex794, depth794 := position, tokenIndex, depth
 					{
-						position561 := position
+						position796 := position
 						depth++
 						{
-							position562, tokenIndex562, depth562 := position, tokenIndex, depth
+							position797, tokenIndex797, depth797 := position, tokenIndex, depth
 							if buffer[position] != rune('e') {
-								goto l563
+								goto l798
 							}
 							position++
-							goto l562
-						l563:
-							position, tokenIndex, depth = position562, tokenIndex562, depth562
+							goto l797
+						l798:
+							position, tokenIndex, depth = position797, tokenIndex797, depth797
 							if buffer[position] != rune('E') {
-								goto l559
+								goto l794
 							}
 							position++
 						}
-					l562:
+					l797:
 						{
-							position564, tokenIndex564, depth564 := position, tokenIndex, depth
+							position799, tokenIndex799, depth799 := position, tokenIndex, depth
 							{
-								position566, tokenIndex566, depth566 := position, tokenIndex, depth
+								position801, tokenIndex801, depth801 := position, tokenIndex, depth
 								if buffer[position] != rune('+') {
-									goto l567
+									goto l802
 								}
 								position++
-								goto l566
-							l567:
-								position, tokenIndex, depth = position566, tokenIndex566, depth566
+								goto l801
+							l802:
+								position, tokenIndex, depth = position801, tokenIndex801, depth801
 								if buffer[position] != rune('-') {
-									goto l564
+									goto l799
 								}
 								position++
 							}
-						l566:
-							goto l565
-						l564:
-							position, tokenIndex, depth = position564, tokenIndex564, depth564
+						l801:
+							goto l800
+						l799:
+							position, tokenIndex, depth = position799, tokenIndex799, depth799
 						}
-					l565:
+					l800:
 						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l559
+							goto l794
 						}
 						position++
-					l568:
+					l803:
 						{
-							position569, tokenIndex569, depth569 := position, tokenIndex, depth
+							position804, tokenIndex804, depth804 := position, tokenIndex, depth
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l569
+								goto l804
 							}
 							position++
-							goto l568
-						l569:
-							position, tokenIndex, depth = position569, tokenIndex569, depth569
+							goto l803
+						l804:
+							position, tokenIndex, depth = position804, tokenIndex804, depth804
 						}
 						depth--
-						add(ruleNUMBER_EXP, position561)
+						add(ruleNUMBER_EXP, position796)
 					}
-					goto l560
-				l559:
-					position, tokenIndex, depth = position559, tokenIndex559, depth559
+					goto l795
+				l794:
+					position, tokenIndex, depth = position794, tokenIndex794, depth794
 				}
-			l560:
+			l795:
 				depth--
-				add(ruleNUMBER, position545)
+				add(ruleNUMBER, position785)
 			}
 			return true
-		l544:
-			position, tokenIndex, depth = position544, tokenIndex544, depth544
+		l784:
+			position, tokenIndex, depth = position784, tokenIndex784, depth784
 			return false
 		},
-		/* 51 NUMBER_NATURAL <- <('0' / ([1-9] [0-9]*))> */
-		nil,
-		/* 52 NUMBER_FRACTION <- <('.' [0-9]+)> */
+		/* 64 NUMBER_NATURAL <- <('0' / ([1-9] [0-9]*))> */
+		func() bool {
+			position805, tokenIndex805, depth805 := position, tokenIndex, depth
+			{
+				position806 := position
+				depth++
+				{
+					position807, tokenIndex807, depth807 := position, tokenIndex, depth
+					if buffer[position] != rune('0') {
+						goto l808
+					}
+					position++
+					goto l807
+				l808:
+					position, tokenIndex, depth = position807, tokenIndex807, depth807
+					if c := buffer[position]; c < rune('1') || c > rune('9') {
+						goto l805
+					}
+					position++
+				l809:
+					{
+						position810, tokenIndex810, depth810 := position, tokenIndex, depth
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l810
+						}
+						position++
+						goto l809
+					l810:
+						position, tokenIndex, depth = position810, tokenIndex810, depth810
+					}
+				}
+			l807:
+				depth--
+				add(ruleNUMBER_NATURAL, position806)
+			}
+			return true
+		l805:
+			position, tokenIndex, depth = position805, tokenIndex805, depth805
+			return false
+		},
+		/* 65 NUMBER_FRACTION <- <('.' [0-9]+)> */
 		nil,
-		/* 53 NUMBER_INTEGER <- <('-'? NUMBER_NATURAL)> */
+		/* 66 NUMBER_INTEGER <- <('-'? NUMBER_NATURAL)> */
 		nil,
-		/* 54 NUMBER_EXP <- <(('e' / 'E') ('+' / '-')? [0-9]+)> */
+		/* 67 NUMBER_EXP <- <(('e' / 'E') ('+' / '-')? [0-9]+)> */
 		nil,
-		/* 55 DURATION <- <(NUMBER [a-z]+)> */
+		/* 68 DURATION <- <(NUMBER [a-z]+)> */
 		nil,
-		/* 56 PAREN_OPEN <- <'('> */
+		/* 69 PAREN_OPEN <- <'('> */
 		func() bool {
-			position575, tokenIndex575, depth575 := position, tokenIndex, depth
+			position815, tokenIndex815, depth815 := position, tokenIndex, depth
 			{
-				position576 := position
+				position816 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l575
+					goto l815
 				}
 				position++
 				depth--
-				add(rulePAREN_OPEN, position576)
+				add(rulePAREN_OPEN, position816)
 			}
 			return true
-		l575:
-			position, tokenIndex, depth = position575, tokenIndex575, depth575
+		l815:
+			position, tokenIndex, depth = position815, tokenIndex815, depth815
 			return false
 		},
-		/* 57 PAREN_CLOSE <- <')'> */
+		/* 70 PAREN_CLOSE <- <')'> */
 		func() bool {
-			position577, tokenIndex577, depth577 := position, tokenIndex, depth
+			position817, tokenIndex817, depth817 := position, tokenIndex, depth
 			{
-				position578 := position
+				position818 := position
 				depth++
 				if buffer[position] != rune(')') {
-					goto l577
+					goto l817
 				}
 				position++
 				depth--
-				add(rulePAREN_CLOSE, position578)
+				add(rulePAREN_CLOSE, position818)
 			}
 			return true
-		l577:
-			position, tokenIndex, depth = position577, tokenIndex577, depth577
+		l817:
+			position, tokenIndex, depth = position817, tokenIndex817, depth817
 			return false
 		},
-		/* 58 COMMA <- <','> */
+		/* 71 COMMA <- <','> */
 		func() bool {
-			position579, tokenIndex579, depth579 := position, tokenIndex, depth
+			position819, tokenIndex819, depth819 := position, tokenIndex, depth
 			{
-				position580 := position
+				position820 := position
 				depth++
 				if buffer[position] != rune(',') {
-					goto l579
+					goto l819
 				}
 				position++
 				depth--
-				add(ruleCOMMA, position580)
+				add(ruleCOMMA, position820)
 			}
 			return true
-		l579:
-			position, tokenIndex, depth = position579, tokenIndex579, depth579
+		l819:
+			position, tokenIndex, depth = position819, tokenIndex819, depth819
 			return false
 		},
-		/* 59 _ <- <SPACE*> */
+		/* 72 _ <- <SPACE*> */
 		func() bool {
 			{
-				position582 := position
+				position822 := position
 				depth++
-			l583:
+			l823:
 				{
-					position584, tokenIndex584, depth584 := position, tokenIndex, depth
+					position824, tokenIndex824, depth824 := position, tokenIndex, depth
 					{
-						position585 := position
+						position825 := position
 						depth++
 						{
 							switch buffer[position] {
 							case '\t':
 								if buffer[position] != rune('\t') {
-									goto l584
+									goto l824
 								}
 								position++
 								break
 							case '\n':
 								if buffer[position] != rune('\n') {
-									goto l584
+									goto l824
 								}
 								position++
 								break
 							default:
 								if buffer[position] != rune(' ') {
-									goto l584
+									goto l824
 								}
 								position++
 								break
@@ -4995,170 +6823,231 @@ func (p *Parser) Init() {
 						}
 
 						depth--
-						add(ruleSPACE, position585)
+						add(ruleSPACE, position825)
 					}
-					goto l583
-				l584:
-					position, tokenIndex, depth = position584, tokenIndex584, depth584
+					goto l823
+				l824:
+					position, tokenIndex, depth = position824, tokenIndex824, depth824
 				}
 				depth--
-				add(rule_, position582)
+				add(rule_, position822)
 			}
 			return true
 		},
-		/* 60 KEY <- <!ID_CONT> */
+		/* 73 KEY <- <!ID_CONT> */
 		func() bool {
-			position587, tokenIndex587, depth587 := position, tokenIndex, depth
+			position827, tokenIndex827, depth827 := position, tokenIndex, depth
 			{
-				position588 := position
+				position828 := position
 				depth++
 				{
-					position589, tokenIndex589, depth589 := position, tokenIndex, depth
+					position829, tokenIndex829, depth829 := position, tokenIndex, depth
 					if !_rules[ruleID_CONT]() {
-						goto l589
+						goto l829
 					}
-					goto l587
-				l589:
-					position, tokenIndex, depth = position589, tokenIndex589, depth589
+					goto l827
+				l829:
+					position, tokenIndex, depth = position829, tokenIndex829, depth829
 				}
 				depth--
-				add(ruleKEY, position588)
+				add(ruleKEY, position828)
 			}
 			return true
-		l587:
-			position, tokenIndex, depth = position587, tokenIndex587, depth587
+		l827:
+			position, tokenIndex, depth = position827, tokenIndex827, depth827
 			return false
 		},
-		/* 61 SPACE <- <((&('\t') '\t') | (&('\n') '\n') | (&(' ') ' '))> */
+		/* 74 SPACE <- <((&('\t') '\t') | (&('\n') '\n') | (&(' ') ' '))> */
 		nil,
-		/* 63 Action0 <- <{
+		/* 76 Action0 <- <{
 		   p.makeSelect()
 		 }> */
 		nil,
-		/* 64 Action1 <- <{ p.makeDescribeAll() }> */
+		/* 77 Action1 <- <{ p.makeDescribeAll() }> */
+		nil,
+		/* 78 Action2 <- <{ p.makeDescribeMetrics() }> */
+		nil,
+		nil,
+		/* 80 Action3 <- <{ p.addStringLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		nil,
+		/* 81 Action4 <- <{
+		   p.addStringLiteral("")
+		   p.addNullPredicate()
+		 }> */
+		nil,
+		/* 82 Action5 <- <{ p.makeDescribeTags() }> */
+		nil,
+		/* 83 Action6 <- <{ p.addStringLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		nil,
+		/* 84 Action7 <- <{ p.addStringLiteral("") }> */
+		nil,
+		/* 85 Action8 <- <{ p.makeDescribeValues() }> */
+		nil,
+		/* 86 Action9 <- <{ p.addStringLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		nil,
+		/* 87 Action10 <- <{ p.addStringLiteral("") }> */
+		nil,
+		/* 88 Action11 <- <{ p.makeDescribeCardinality() }> */
+		nil,
+		/* 89 Action12 <- <{ p.addStringLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		nil,
+		/* 90 Action13 <- <{ p.makeDescribe() }> */
+		nil,
+		/* 91 Action14 <- <{ p.addEvaluationContext() }> */
+		nil,
+		/* 92 Action15 <- <{ p.addPropertyKey(buffer[begin:end])   }> */
+		nil,
+		/* 93 Action16 <- <{ p.addPropertyValue(buffer[begin:end]) }> */
+		nil,
+		/* 94 Action17 <- <{ p.insertPropertyKeyValue() }> */
+		nil,
+		/* 95 Action18 <- <{ p.checkPropertyClause() }> */
+		nil,
+		/* 96 Action19 <- <{ p.addPagination() }> */
+		nil,
+		/* 97 Action20 <- <{
+		   p.setOrderSummary(buffer[begin:end])
+		 }> */
+		nil,
+		/* 98 Action21 <- <{ p.setOrderTag(unescapeLiteral(buffer[begin:end])) }> */
 		nil,
-		/* 65 Action2 <- <{ p.makeDescribeMetrics() }> */
+		/* 99 Action22 <- <{ p.setOrderDescending() }> */
 		nil,
+		/* 100 Action23 <- <{ p.setLimit(buffer[begin:end]) }> */
 		nil,
-		/* 67 Action3 <- <{ p.addStringLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		/* 101 Action24 <- <{ p.setOffset(buffer[begin:end]) }> */
 		nil,
-		/* 68 Action4 <- <{ p.makeDescribe() }> */
+		/* 102 Action25 <- <{ p.addNullPredicate() }> */
 		nil,
-		/* 69 Action5 <- <{ p.addEvaluationContext() }> */
+		/* 103 Action26 <- <{ p.addExpressionList() }> */
 		nil,
-		/* 70 Action6 <- <{ p.addPropertyKey(buffer[begin:end])   }> */
+		/* 104 Action27 <- <{ p.appendExpression() }> */
 		nil,
-		/* 71 Action7 <- <{ p.addPropertyValue(buffer[begin:end]) }> */
+		/* 105 Action28 <- <{ p.appendExpression() }> */
 		nil,
-		/* 72 Action8 <- <{ p.insertPropertyKeyValue() }> */
+		/* 106 Action29 <- <{ p.addOperatorLiteral(">=") }> */
 		nil,
-		/* 73 Action9 <- <{ p.checkPropertyClause() }> */
+		/* 107 Action30 <- <{ p.addOperatorLiteral("<=") }> */
 		nil,
-		/* 74 Action10 <- <{ p.addNullPredicate() }> */
+		/* 108 Action31 <- <{ p.addOperatorLiteral("==") }> */
 		nil,
-		/* 75 Action11 <- <{ p.addExpressionList() }> */
+		/* 109 Action32 <- <{ p.addOperatorLiteral("!=") }> */
 		nil,
-		/* 76 Action12 <- <{ p.appendExpression() }> */
+		/* 110 Action33 <- <{ p.addOperatorLiteral(">") }> */
 		nil,
-		/* 77 Action13 <- <{ p.appendExpression() }> */
+		/* 111 Action34 <- <{ p.addOperatorLiteral("<") }> */
 		nil,
-		/* 78 Action14 <- <{ p.addOperatorLiteral("+") }> */
+		/* 112 Action35 <- <{ p.addBooleanModifier() }> */
 		nil,
-		/* 79 Action15 <- <{ p.addOperatorLiteral("-") }> */
+		/* 113 Action36 <- <{ p.addOperatorFunction() }> */
 		nil,
-		/* 80 Action16 <- <{ p.addOperatorFunction() }> */
+		/* 114 Action37 <- <{ p.addOperatorLiteral("+") }> */
 		nil,
-		/* 81 Action17 <- <{ p.addOperatorLiteral("/") }> */
+		/* 115 Action38 <- <{ p.addOperatorLiteral("-") }> */
 		nil,
-		/* 82 Action18 <- <{ p.addOperatorLiteral("*") }> */
+		/* 116 Action39 <- <{ p.addOperatorFunction() }> */
 		nil,
-		/* 83 Action19 <- <{ p.addOperatorFunction() }> */
+		/* 117 Action40 <- <{ p.addOperatorLiteral("/") }> */
 		nil,
-		/* 84 Action20 <- <{
+		/* 118 Action41 <- <{ p.addOperatorLiteral("*") }> */
+		nil,
+		/* 119 Action42 <- <{ p.addOperatorFunction() }> */
+		nil,
+		/* 120 Action43 <- <{
 		   p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		 }> */
 		nil,
-		/* 85 Action21 <- <{p.addExpressionList()}> */
+		/* 121 Action44 <- <{p.addExpressionList()}> */
 		nil,
-		/* 86 Action22 <- <{ p.addGroupBy() }> */
+		/* 122 Action45 <- <{ p.addGroupBy() }> */
 		nil,
-		/* 87 Action23 <- <{
+		/* 123 Action46 <- <{
 		   p.addExpressionList()
 		   p.addGroupBy()
 		 }> */
 		nil,
-		/* 88 Action24 <- <{
+		/* 124 Action47 <- <{
 		   p.addPipeExpression()
 		 }> */
 		nil,
-		/* 89 Action25 <- <{ p.addDurationNode(text) }> */
+		/* 125 Action48 <- <{ p.addDurationNode(text) }> */
 		nil,
-		/* 90 Action26 <- <{ p.addNumberNode(buffer[begin:end]) }> */
+		/* 126 Action49 <- <{ p.addNumberNode(buffer[begin:end]) }> */
 		nil,
-		/* 91 Action27 <- <{ p.addStringNode(unescapeLiteral(buffer[begin:end])) }> */
+		/* 127 Action50 <- <{ p.addStringNode(unescapeLiteral(buffer[begin:end])) }> */
 		nil,
-		/* 92 Action28 <- <{
+		/* 128 Action51 <- <{
 		   p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		 }> */
 		nil,
-		/* 93 Action29 <- <{ p.addGroupBy() }> */
+		/* 129 Action52 <- <{ p.addGroupBy() }> */
 		nil,
-		/* 94 Action30 <- <{
+		/* 130 Action53 <- <{
 		   p.addFunctionInvocation()
 		 }> */
 		nil,
-		/* 95 Action31 <- <{
+		/* 131 Action54 <- <{
 		   p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		 }> */
 		nil,
-		/* 96 Action32 <- <{ p.addNullPredicate() }> */
+		/* 132 Action55 <- <{ p.addNullPredicate() }> */
 		nil,
-		/* 97 Action33 <- <{
+		/* 133 Action56 <- <{
 		   p.addMetricExpression()
 		 }> */
 		nil,
-		/* 98 Action34 <- <{
+		/* 134 Action57 <- <{
+		   p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
+		 }> */
+		nil,
+		/* 135 Action58 <- <{ p.addNullPredicate() }> */
+		nil,
+		/* 136 Action59 <- <{
+		   p.addMetricPatternExpression()
+		 }> */
+		nil,
+		/* 137 Action60 <- <{
 		   p.appendGroupBy(unescapeLiteral(buffer[begin:end]))
 		 }> */
 		nil,
-		/* 99 Action35 <- <{
+		/* 138 Action61 <- <{
 		   p.appendGroupBy(unescapeLiteral(buffer[begin:end]))
 		   }> */
 		nil,
-		/* 100 Action36 <- <{ p.addOrPredicate() }> */
+		/* 139 Action62 <- <{ p.addOrPredicate() }> */
 		nil,
-		/* 101 Action37 <- <{ p.addAndPredicate() }> */
+		/* 140 Action63 <- <{ p.addAndPredicate() }> */
 		nil,
-		/* 102 Action38 <- <{ p.addNotPredicate() }> */
+		/* 141 Action64 <- <{ p.addNotPredicate() }> */
 		nil,
-		/* 103 Action39 <- <{
+		/* 142 Action65 <- <{
 		   p.addLiteralMatcher()
 		 }> */
 		nil,
-		/* 104 Action40 <- <{
+		/* 143 Action66 <- <{
 		   p.addLiteralMatcher()
 		   p.addNotPredicate()
 		 }> */
 		nil,
-		/* 105 Action41 <- <{
+		/* 144 Action67 <- <{
 		   p.addRegexMatcher()
 		 }> */
 		nil,
-		/* 106 Action42 <- <{
+		/* 145 Action68 <- <{
 		   p.addListMatcher()
 		 }> */
 		nil,
-		/* 107 Action43 <- <{
+		/* 146 Action69 <- <{
 		  p.addStringLiteral(unescapeLiteral(buffer[begin:end]))
 		}> */
 		nil,
-		/* 108 Action44 <- <{ p.addLiteralList() }> */
+		/* 147 Action70 <- <{ p.addLiteralList() }> */
 		nil,
-		/* 109 Action45 <- <{
+		/* 148 Action71 <- <{
 		  p.appendLiteral(unescapeLiteral(buffer[begin:end]))
 		}> */
 		nil,
-		/* 110 Action46 <- <{ p.addTagLiteral(unescapeLiteral(buffer[begin:end])) }> */
+		/* 149 Action72 <- <{ p.addTagLiteral(unescapeLiteral(buffer[begin:end])) }> */
 		nil,
 	}
 	p.rules = _rules
@@ -83,9 +83,12 @@ type stringExpression struct {
 }
 
 // metricFetchExpression represents a reference to a metric embedded within the expression.
+// If the pattern is non-nil, every metric whose name it matches is fetched,
+// and the metric name is included in the tags of each series as `__name__`.
 type metricFetchExpression struct {
 	metricName string
 	predicate  api.Predicate
+	pattern    *regexp.Regexp
 }
 
 // functionExpression represents a function call with subexpressions.
@@ -151,6 +154,15 @@ type evaluationContextNode struct {
 	assigned     map[string]bool  // a map for knowing which elements of the context have been assigned
 }
 
+// paginationNode represents the order by, limit and offset clauses of a select statement.
+type paginationNode struct {
+	orderTag     string // tag to order by; empty if none
+	orderSummary string // summary to order by; empty if none
+	descending   bool   // order in descending instead of ascending order
+	limit        int    // maximum number of series in each list; -1 if unlimited
+	offset       int    // number of series skipped in each list
+}
+
 // Helper functions for printing
 // =============================
 func printHelper(buffer *bytes.Buffer, indent int, value string) {
@@ -271,6 +283,7 @@ func (node *functionExpression) Print(buffer *bytes.Buffer, indent int) {
 func (node *metricFetchExpression) Print(buffer *bytes.Buffer, indent int) {
 	printType(buffer, indent, node)
 	printHelper(buffer, indent+1, node.metricName)
+	printUnknown(buffer, indent+1, node.pattern)
 	printUnknown(buffer, indent+1, node.predicate)
 }
 
@@ -293,6 +306,15 @@ func (node *evaluationContextNode) Print(buffer *bytes.Buffer, indent int) {
 	printUnknown(buffer, indent+1, node.assigned)
 }
 
+func (node *paginationNode) Print(buffer *bytes.Buffer, indent int) {
+	printType(buffer, indent, node)
+	printUnknown(buffer, indent+1, node.orderTag)
+	printUnknown(buffer, indent+1, node.orderSummary)
+	printUnknown(buffer, indent+1, node.descending)
+	printUnknown(buffer, indent+1, node.limit)
+	printUnknown(buffer, indent+1, node.offset)
+}
+
 // Commands
 
 func (node *DescribeCommand) Print(buffer *bytes.Buffer, indent int) {
@@ -302,6 +324,27 @@ func (node *DescribeCommand) Print(buffer *bytes.Buffer, indent int) {
 	printUnknown(buffer, indent, node.predicate)
 }
 
+func (node *DescribeTagsCommand) Print(buffer *bytes.Buffer, indent int) {
+	printType(buffer, indent, node)
+	indent++
+	printUnknown(buffer, indent, node.metricName)
+	printUnknown(buffer, indent, node.predicate)
+}
+
+func (node *DescribeValuesCommand) Print(buffer *bytes.Buffer, indent int) {
+	printType(buffer, indent, node)
+	indent++
+	printUnknown(buffer, indent, node.tagKey)
+	printUnknown(buffer, indent, node.metricName)
+	printUnknown(buffer, indent, node.predicate)
+}
+
+func (node *DescribeCardinalityCommand) Print(buffer *bytes.Buffer, indent int) {
+	printType(buffer, indent, node)
+	indent++
+	printUnknown(buffer, indent, node.metricName)
+}
+
 func (node *DescribeAllCommand) Print(buffer *bytes.Buffer, indent int) {
 	buffer.WriteString("describe all\n")
 }
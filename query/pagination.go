@@ -0,0 +1,133 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"math"
+	"sort"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/function"
+	"github.com/square/metrics/function/aggregate"
+)
+
+// orderSummaries are the summaries which can be used in `order by summary(...)`.
+var orderSummaries = map[string]func([]float64) float64{
+	"max":  aggregate.Max,
+	"min":  aggregate.Min,
+	"mean": aggregate.Mean,
+	"sum":  aggregate.Sum,
+}
+
+// isEmpty determines whether no order by, limit or offset clause was given.
+func (node *paginationNode) isEmpty() bool {
+	return node.orderTag == "" && node.orderSummary == "" && node.limit < 0 && node.offset == 0
+}
+
+// seriesOrder sorts a list of series by a tag or summary value.
+// Ties (and NaN summaries, which always go last) are broken by the serialized tagset,
+// so that the result is deterministic.
+type seriesOrder struct {
+	series     []api.Timeseries
+	tags       []string
+	summaries  []float64
+	serialized []string
+	descending bool
+}
+
+func (order seriesOrder) Len() int {
+	return len(order.series)
+}
+
+func (order seriesOrder) Less(i, j int) bool {
+	if order.tags[i] != order.tags[j] {
+		return (order.tags[i] < order.tags[j]) != order.descending
+	}
+	iNaN, jNaN := math.IsNaN(order.summaries[i]), math.IsNaN(order.summaries[j])
+	if iNaN != jNaN {
+		return jNaN
+	}
+	if !iNaN && order.summaries[i] != order.summaries[j] {
+		return (order.summaries[i] < order.summaries[j]) != order.descending
+	}
+	return order.serialized[i] < order.serialized[j]
+}
+
+func (order seriesOrder) Swap(i, j int) {
+	order.series[i], order.series[j] = order.series[j], order.series[i]
+	order.tags[i], order.tags[j] = order.tags[j], order.tags[i]
+	order.summaries[i], order.summaries[j] = order.summaries[j], order.summaries[i]
+	order.serialized[i], order.serialized[j] = order.serialized[j], order.serialized[i]
+}
+
+// apply sorts and slices the given list according to the pagination clauses.
+// The original list is not modified.
+func (node *paginationNode) apply(list api.SeriesList) api.SeriesList {
+	series := make([]api.Timeseries, len(list.Series))
+	copy(series, list.Series)
+	if node.orderTag != "" || node.orderSummary != "" {
+		order := seriesOrder{
+			series:     series,
+			tags:       make([]string, len(series)),
+			summaries:  make([]float64, len(series)),
+			serialized: make([]string, len(series)),
+			descending: node.descending,
+		}
+		for i := range series {
+			if node.orderTag != "" {
+				order.tags[i] = series[i].TagSet[node.orderTag]
+			}
+			if node.orderSummary != "" {
+				order.summaries[i] = orderSummaries[node.orderSummary](series[i].Values)
+			}
+			order.serialized[i] = series[i].TagSet.Serialize()
+		}
+		sort.Sort(order)
+	}
+	start := node.offset
+	if start > len(series) {
+		start = len(series)
+	}
+	end := len(series)
+	if node.limit >= 0 && start+node.limit < end {
+		end = start + node.limit
+	}
+	list.Series = series[start:end]
+	return list
+}
+
+// paginate applies the pagination clauses to every series list in `values`.
+// It returns the paginated values along with the metadata describing the pagination,
+// which includes the total number of series in each list before it was sliced.
+func (node *paginationNode) paginate(values []function.Value) ([]function.Value, map[string]interface{}) {
+	result := make([]function.Value, len(values))
+	totals := make([]int, len(values))
+	for i, value := range values {
+		result[i] = value
+		totals[i] = 1
+		if list, ok := value.(function.SeriesListValue); ok {
+			totals[i] = len(list.Series)
+			result[i] = function.SeriesListValue(node.apply(api.SeriesList(list)))
+		}
+	}
+	metadata := map[string]interface{}{
+		"offset": node.offset,
+		"total":  totals,
+	}
+	if node.limit >= 0 {
+		metadata["limit"] = node.limit
+	}
+	return result, metadata
+}
@@ -236,6 +236,11 @@ func (p *Parser) makeDescribe() {
 }
 
 func (p *Parser) makeSelect() {
+	paginationNode, ok := p.popNode(paginationNodePointer).(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
 	contextNode, ok := p.popNode(evaluationContextNodePointer).(*evaluationContextNode)
 	if !ok {
 		p.flagTypeAssertion()
@@ -255,6 +260,7 @@ func (p *Parser) makeSelect() {
 		predicate:   predicateNode,
 		expressions: expressionList.expressions,
 		context:     contextNode,
+		pagination:  paginationNode,
 	}
 }
 
@@ -263,7 +269,39 @@ func (p *Parser) makeDescribeAll() {
 }
 
 func (p *Parser) makeDescribeMetrics() {
-	// Pop off the value.
+	predicateNode, ok := p.popNode(predicateType).(api.Predicate)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	p.command = &DescribeMetricsCommand{predicate: predicateNode}
+}
+
+func (p *Parser) makeDescribeTags() {
+	predicateNode, ok := p.popNode(predicateType).(api.Predicate)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	// Pop off the metric name (empty if none was given).
+	stringLiteral, ok := p.popNode(stringLiteralPointer).(*stringLiteral)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	p.command = &DescribeTagsCommand{
+		metricName: api.MetricKey(stringLiteral.literal),
+		predicate:  predicateNode,
+	}
+}
+
+func (p *Parser) makeDescribeValues() {
+	predicateNode, ok := p.popNode(predicateType).(api.Predicate)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	// Pop off the metric name (empty if none was given).
 	stringLiteral, ok := p.popNode(stringLiteralPointer).(*stringLiteral)
 	if !ok {
 		p.flagTypeAssertion()
@@ -275,13 +313,40 @@ func (p *Parser) makeDescribeMetrics() {
 		p.flagTypeAssertion()
 		return
 	}
-	p.command = &DescribeMetricsCommand{tagKey: tagLiteral.tag, tagValue: stringLiteral.literal}
+	p.command = &DescribeValuesCommand{
+		tagKey:     tagLiteral.tag,
+		metricName: api.MetricKey(stringLiteral.literal),
+		predicate:  predicateNode,
+	}
+}
+
+func (p *Parser) makeDescribeCardinality() {
+	// Pop off the metric name (empty if none was given).
+	stringLiteral, ok := p.popNode(stringLiteralPointer).(*stringLiteral)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	p.command = &DescribeCardinalityCommand{
+		metricName: api.MetricKey(stringLiteral.literal),
+	}
 }
 
 func (p *Parser) addOperatorLiteral(operator string) {
 	p.pushNode(&operatorLiteral{operator})
 }
 
+// addBooleanModifier marks the comparison operator on top of the stack
+// as producing 0/1 values instead of filtering.
+func (p *Parser) addBooleanModifier() {
+	operatorNode, ok := p.popNode(operatorLiteralPointer).(*operatorLiteral)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	p.pushNode(&operatorLiteral{operatorNode.operator + " bool"})
+}
+
 func (p *Parser) addOperatorFunction() {
 	right, ok := p.popNode(expressionType).(function.Expression)
 	if !ok {
@@ -423,6 +488,73 @@ func (p *Parser) checkPropertyClause() {
 	p.pushNode(contextNode)
 }
 
+func (p *Parser) addPagination() {
+	p.pushNode(&paginationNode{limit: -1})
+}
+
+func (p *Parser) setOrderTag(tag string) {
+	paginationNode, ok := p.peekNode().(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	paginationNode.orderTag = tag
+}
+
+func (p *Parser) setOrderSummary(summary string) {
+	paginationNode, ok := p.peekNode().(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	if _, ok := orderSummaries[summary]; !ok {
+		p.flagSyntaxError(SyntaxError{
+			token:   summary,
+			message: fmt.Sprintf("Expected summary 'max', 'min', 'mean' or 'sum' but got %s", summary),
+		})
+	}
+	paginationNode.orderSummary = summary
+}
+
+func (p *Parser) setOrderDescending() {
+	paginationNode, ok := p.peekNode().(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	paginationNode.descending = true
+}
+
+func (p *Parser) setLimit(value string) {
+	paginationNode, ok := p.peekNode().(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	paginationNode.limit = p.parseCount(value)
+}
+
+func (p *Parser) setOffset(value string) {
+	paginationNode, ok := p.peekNode().(*paginationNode)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	paginationNode.offset = p.parseCount(value)
+}
+
+// parseCount converts a natural number used in a limit or offset clause.
+func (p *Parser) parseCount(value string) int {
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		p.flagSyntaxError(SyntaxError{
+			token:   value,
+			message: fmt.Sprintf("Expected count but parse failed; %s", err.Error()),
+		})
+	}
+	return count
+}
+
 func (p *Parser) addPipeExpression() {
 	groupBy, ok := p.popNode(groupByListPointer).(*groupByList)
 	if !ok {
@@ -486,12 +618,50 @@ func (p *Parser) addMetricExpression() {
 		p.flagTypeAssertion()
 		return
 	}
+	if strings.Contains(stringLiteral.literal, "*") {
+		// The name is a wildcard pattern, where `*` matches any sequence of characters.
+		parts := strings.Split(stringLiteral.literal, "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		p.pushNode(&metricFetchExpression{
+			metricName: stringLiteral.literal,
+			pattern:    regexp.MustCompile("^" + strings.Join(parts, ".*") + "$"),
+			predicate:  predicateNode,
+		})
+		return
+	}
 	p.pushNode(&metricFetchExpression{
 		metricName: stringLiteral.literal,
 		predicate:  predicateNode,
 	})
 }
 
+func (p *Parser) addMetricPatternExpression() {
+	predicateNode, ok := p.popNode(predicateType).(api.Predicate)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	stringLiteral, ok := p.popNode(stringLiteralPointer).(*stringLiteral)
+	if !ok {
+		p.flagTypeAssertion()
+		return
+	}
+	compiled, err := regexp.Compile(stringLiteral.literal)
+	if err != nil {
+		p.flagSyntaxError(SyntaxError{
+			token:   stringLiteral.literal,
+			message: fmt.Sprintf("Cannot parse the regex: %s", err.Error()),
+		})
+	}
+	p.pushNode(&metricFetchExpression{
+		metricName: fmt.Sprintf("metric matches '%s'", stringLiteral.literal),
+		pattern:    compiled,
+		predicate:  predicateNode,
+	})
+}
+
 func (p *Parser) addExpressionList() {
 	p.pushNode(&expressionList{
 		make([]function.Expression, 0),
@@ -733,4 +903,5 @@ var (
 	evaluationContextValuePointer = reflect.TypeOf((*evaluationContextValue)(nil))
 	evaluationContextKeyPointer   = reflect.TypeOf((*evaluationContextKey)(nil))
 	evaluationContextNodePointer  = reflect.TypeOf((*evaluationContextNode)(nil))
+	paginationNodePointer         = reflect.TypeOf((*paginationNode)(nil))
 )
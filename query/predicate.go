@@ -15,6 +15,8 @@
 package query
 
 import (
+	"sort"
+
 	"github.com/square/metrics/api"
 )
 
@@ -60,6 +62,166 @@ func (matcher *regexMatcher) Apply(tagSet api.TagSet) bool {
 	return matcher.regex.MatchString(tagSet[matcher.tag])
 }
 
+// Constraints of a conjunction require every clause's constraints to hold.
+// When several clauses constrain the same tag, only their common values remain.
+func (matcher *andPredicate) Constraints() map[string][]string {
+	result := map[string][]string{}
+	for _, subPredicate := range matcher.predicates {
+		indexable, ok := subPredicate.(api.IndexablePredicate)
+		if !ok {
+			continue
+		}
+		for tag, values := range indexable.Constraints() {
+			previous, ok := result[tag]
+			if !ok {
+				result[tag] = values
+				continue
+			}
+			result[tag] = intersectValues(previous, values)
+		}
+	}
+	return result
+}
+
+// Constraints of a disjunction are the tags constrained by every clause,
+// which may take any of the values allowed by some clause.
+func (matcher *orPredicate) Constraints() map[string][]string {
+	result := map[string][]string{}
+	for i, subPredicate := range matcher.predicates {
+		indexable, ok := subPredicate.(api.IndexablePredicate)
+		if !ok {
+			return map[string][]string{}
+		}
+		constraints := indexable.Constraints()
+		if i == 0 {
+			for tag, values := range constraints {
+				result[tag] = values
+			}
+			continue
+		}
+		for tag, values := range result {
+			other, ok := constraints[tag]
+			if !ok {
+				delete(result, tag)
+				continue
+			}
+			result[tag] = unionValues(values, other)
+		}
+	}
+	return result
+}
+
+func (matcher *listMatcher) Constraints() map[string][]string {
+	return map[string][]string{matcher.tag: matcher.values}
+}
+
+func intersectValues(left, right []string) []string {
+	result := []string{}
+	for _, value := range left {
+		for _, other := range right {
+			if value == other {
+				result = append(result, value)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func unionValues(left, right []string) []string {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, values := range [][]string{left, right} {
+		for _, value := range values {
+			if !seen[value] {
+				seen[value] = true
+				result = append(result, value)
+			}
+		}
+	}
+	return result
+}
+
 func matchPrecondition(matcherTag string, tagSet api.TagSet) bool {
 	return tagSet.HasKey(matcherTag)
 }
+
+// metricSet is a set of metrics found using the tag index.
+// If it is exact, every metric in the set has a tagset satisfying the predicate.
+// Otherwise, it is a superset of those metrics.
+type metricSet struct {
+	metrics map[api.MetricKey]bool
+	exact   bool
+}
+
+// keys returns the metrics in the set in sorted order.
+func (set *metricSet) keys() []api.MetricKey {
+	result := make([]api.MetricKey, 0, len(set.metrics))
+	for key := range set.metrics {
+		result = append(result, key)
+	}
+	sort.Sort(api.MetricKeys(result))
+	return result
+}
+
+// indexCandidates uses the tag index to find the metrics which may have a tagset satisfying the predicate.
+// Tag matchers are answered by the index; `or` takes the union and `and` the intersection of their clauses.
+// Since the clauses of an `and` may be satisfied by different tagsets of the same metric, the intersection is not exact.
+// It returns nil if the index can't narrow down the metrics (e.g. for `not` or `matches`).
+func indexCandidates(predicate api.Predicate, apiInstance api.API) (*metricSet, error) {
+	switch predicate := predicate.(type) {
+	case *listMatcher:
+		set := &metricSet{metrics: map[api.MetricKey]bool{}, exact: true}
+		for _, value := range predicate.values {
+			keys, err := apiInstance.GetMetricsForTag(predicate.tag, value)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				set.metrics[key] = true
+			}
+		}
+		return set, nil
+	case *orPredicate:
+		set := &metricSet{metrics: map[api.MetricKey]bool{}, exact: true}
+		for _, subPredicate := range predicate.predicates {
+			subSet, err := indexCandidates(subPredicate, apiInstance)
+			if err != nil || subSet == nil {
+				// If any clause can't be answered, neither can the union.
+				return nil, err
+			}
+			for key := range subSet.metrics {
+				set.metrics[key] = true
+			}
+			set.exact = set.exact && subSet.exact
+		}
+		return set, nil
+	case *andPredicate:
+		var set *metricSet
+		for _, subPredicate := range predicate.predicates {
+			subSet, err := indexCandidates(subPredicate, apiInstance)
+			if err != nil {
+				return nil, err
+			}
+			if subSet == nil {
+				// This clause will be checked when the candidates are scanned.
+				continue
+			}
+			if set == nil {
+				set = subSet
+				continue
+			}
+			for key := range set.metrics {
+				if !subSet.metrics[key] {
+					delete(set.metrics, key)
+				}
+			}
+		}
+		if set != nil {
+			set.exact = set.exact && len(predicate.predicates) == 1
+		}
+		return set, nil
+	default:
+		return nil, nil
+	}
+}
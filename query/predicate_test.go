@@ -0,0 +1,60 @@
+// Copyright 2015 Square Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"testing"
+
+	"github.com/square/metrics/api"
+	"github.com/square/metrics/assert"
+)
+
+func TestPredicateConstraints(t *testing.T) {
+	for _, test := range []struct {
+		predicate string
+		expected  map[string][]string
+	}{
+		{"dc = 'west'", map[string][]string{"dc": {"west"}}},
+		{"dc in ('west', 'east')", map[string][]string{"dc": {"west", "east"}}},
+		{"dc = 'west' and env = 'production'", map[string][]string{"dc": {"west"}, "env": {"production"}}},
+		{"dc in ('west', 'east') and dc = 'east'", map[string][]string{"dc": {"east"}}},
+		{"dc = 'west' and dc = 'east'", map[string][]string{"dc": {}}},
+		{"dc = 'west' and host matches 'a'", map[string][]string{"dc": {"west"}}},
+		{"dc = 'west' or dc = 'east'", map[string][]string{"dc": {"west", "east"}}},
+		{"dc = 'west' or env = 'production'", map[string][]string{}},
+		{"(dc = 'west' and env = 'production') or dc = 'east'", map[string][]string{"dc": {"west", "east"}}},
+		{"dc = 'west' or host matches 'a'", map[string][]string{}},
+		{"not dc = 'west'", nil},
+		{"host matches 'a'", nil},
+	} {
+		a := assert.New(t).Contextf("predicate=%s", test.predicate)
+		command, err := Parse("describe metrics where " + test.predicate)
+		if err != nil {
+			a.Errorf("Unexpected error while parsing: %s", err.Error())
+			continue
+		}
+		predicate := command.(*DescribeMetricsCommand).predicate
+		indexable, ok := predicate.(api.IndexablePredicate)
+		if test.expected == nil {
+			a.EqBool(ok, false)
+			continue
+		}
+		if !ok {
+			a.Errorf("Expected predicate to be indexable")
+			continue
+		}
+		a.Eq(indexable.Constraints(), test.expected)
+	}
+}
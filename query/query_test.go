@@ -43,6 +43,22 @@ var inputs = []string{
 	"describe cpu_usage where key in ('value', 'value')",
 	"describe cpu_usage where key matches 'abc'",
 	"describe nodes.cpu.usage where datacenter='sjc1b' and type='idle' and host matches 'fwd'",
+	"describe metrics where key = 'value'",
+	"describe metrics where key = 'value' and not other = 'value'",
+	"describe metrics where key in ('a', 'b') or other matches 'c'",
+	"describe metrics where (key = 'value')",
+	"describe tags",
+	"describe tags cpu_usage",
+	"describe tags `cpu_usage` where key = 'value'",
+	"describe values host",
+	"describe values host for cpu_usage",
+	"describe values `host` for cpu_usage where dc = 'west' and host matches 'a'",
+	"describe values host where host matches 'a'",
+	"describe cardinality",
+	"describe cardinality cpu_usage",
+	"describe cardinality `cpu.usage`",
+	"describe tagsx",
+	"describe valuesx",
 	// predicate parenthesis test
 	"describe cpu_usage where key = 'value' and (key = 'value')",
 	"describe cpu_usage where (key = 'value') and key = 'value'",
@@ -65,6 +81,16 @@ var inputs = []string{
 	"select(f(g(5)group by`a`,w,q)) from 0 to 0",
 	"select(f(g(5)group by`a`,w,q)) from 0 to 0",
 	"select(fromx+tox+groupx+byx+selectx+describex+allx+wherex) from 0 to 0",
+	// selects - order by, limit and offset
+	"select x from 0 to 0 order by dc",
+	"select x from 0 to 0 order by dc asc",
+	"select x from 0 to 0 order by `dc` desc",
+	"select x from 0 to 0 order by summary(max) desc",
+	"select x from 0 to 0 order by summary ( mean )",
+	"select x from 0 to 0 limit 10",
+	"select x from 0 to 0 offset 10",
+	"select x where dc = 'west' from 0 to 0 order by host limit 10 offset 20",
+	"select x from 0 to 0 resolution '30s' order by summary(sum) asc limit 0",
 }
 
 var selects = []string{
@@ -139,6 +165,27 @@ var selects = []string{
 	"x|f(1s,2,3y) + y|g(4mo) from 0 to 0",
 	"x|f(1s,'r3r2',3y) + y|g(4mo) from 0 to 0",
 	"1 + 2 | f from 0 to 0",
+	// comparisons
+	"x > 1 from 0 to 0",
+	"x < 1 from 0 to 0",
+	"x >= 1 from 0 to 0",
+	"x <= 1 from 0 to 0",
+	"x == y from 0 to 0",
+	"x != y from 0 to 0",
+	"x>1 from 0 to 0",
+	"x + 1 > y * 2 from 0 to 0",
+	"x > bool 1 from 0 to 0",
+	"x >= bool y | f from 0 to 0",
+	"(x > 1) > bool 0 from 0 to 0",
+	"x[y != 'z'] != 0 from 0 to 0",
+	"filter.any_above(x, 10) from 0 to 0",
+	"boolean > bool1 from 0 to 0",
+	// metric patterns
+	"`cpu.*` from 0 to 0",
+	"`cpu.*.user`[host = 'a'] from 0 to 0",
+	"metric matches '^memory\\\\.' from 0 to 0",
+	"metric matches 'cpu' [__name__ != 'cpu.idle'] + 1 from 0 to 0",
+	"metric from 0 to 0",
 }
 
 // these queries should fail with a syntax error.
@@ -148,6 +195,11 @@ var syntaxErrorQuery = []string{
 	"describe ( from 0 to 0",
 	"describe in from 0 to 0",
 	"describe invalid_regex where key matches 'ab[' from 0 to 0",
+	"describe tags where key = 'value'",
+	"describe metrics",
+	"describe metrics where",
+	"describe values host for",
+	"describe cardinality cpu_usage where host = 'a'",
 	"select x invalid_property 0 from 0 to 0",
 	"select x sampleby 0 from 0 to 0",
 	"select x sample 0 from 0 to 0",
@@ -162,6 +214,19 @@ var syntaxErrorQuery = []string{
 	"select f(3 groupby x) from 0 to 0",
 	"select c group by a from 0 to 0",
 	"select x[] from 0 to 0",
+	"select x > y > z from 0 to 0",
+	"select x > bool from 0 to 0",
+	"select x = y from 0 to 0",
+	"select x => y from 0 to 0",
+	"select x from 0 to 0 limit",
+	"select x from 0 to 0 limit -1",
+	"select x from 0 to 0 limit 1.5",
+	"select x from 0 to 0 offset 1 limit 1",
+	"select x from 0 to 0 order dc",
+	"select x from 0 to 0 order by summary(median)",
+	"select x order by dc from 0 to 0",
+	"select metric matches 'ab[' from 0 to 0",
+	"select metric matches x from 0 to 0",
 }
 
 func TestParse_success(t *testing.T) {
@@ -54,7 +54,7 @@ func TestMovingAverage(t *testing.T) {
 		functionName: "transform.moving_average",
 		groupBy:      []string{},
 		arguments: []function.Expression{
-			&metricFetchExpression{"series", api.TruePredicate},
+			&metricFetchExpression{metricName: "series", predicate: api.TruePredicate},
 			stringExpression{"300ms"},
 		},
 	}
@@ -43,6 +43,12 @@ type tokenHandler struct {
 	context query.ExecutionContext
 }
 
+// searchHandler finds metric names matching a prefix or substring, one page at a time.
+type searchHandler struct {
+	hook    Hook
+	context query.ExecutionContext
+}
+
 type queryHandler struct {
 	hook    Hook
 	context query.ExecutionContext
@@ -89,6 +95,49 @@ func parseBool(input string, defaultValue bool) bool {
 	return value
 }
 
+// defaultSearchLimit and maxSearchLimit bound the size of a page of search results.
+// maxSearchOffset bounds how far results can be paged through, since every skipped result is searched for.
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 1000
+	maxSearchOffset    = 10000
+)
+
+type searchForm struct {
+	query  string // text to search for in metric names.
+	limit  int    // maximum number of results in the page.
+	offset int    // number of results to skip.
+}
+
+// parseInt returns the default value for a missing parameter, and rejects a malformed one.
+func parseInt(name string, input string, defaultValue int) (int, error) {
+	if input == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return value, nil
+}
+
+func parseSearchForm(request *http.Request) (form searchForm, err error) {
+	form.query = request.Form.Get("query")
+	if form.limit, err = parseInt("limit", request.Form.Get("limit"), defaultSearchLimit); err != nil {
+		return form, err
+	}
+	if form.offset, err = parseInt("offset", request.Form.Get("offset"), 0); err != nil {
+		return form, err
+	}
+	if form.limit <= 0 || form.limit > maxSearchLimit {
+		return form, fmt.Errorf("limit must be between 1 and %d", maxSearchLimit)
+	}
+	if form.offset < 0 || form.offset > maxSearchOffset {
+		return form, fmt.Errorf("offset must be between 0 and %d", maxSearchOffset)
+	}
+	return form, nil
+}
+
 func parseQueryForm(request *http.Request) (form queryForm) {
 	form.input = request.Form.Get("query")
 	form.profile = parseBool(request.Form.Get("profile"), false)
@@ -128,6 +177,43 @@ func (h tokenHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 	bodyResponse(writer, response)
 }
 
+func (h searchHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	err := request.ParseForm()
+	if err != nil {
+		errorResponse(writer, http.StatusBadRequest, err)
+		return
+	}
+	form, err := parseSearchForm(request)
+	if err != nil {
+		errorResponse(writer, http.StatusBadRequest, err)
+		return
+	}
+	// One extra result is requested to find out whether there is another page.
+	metrics, err := h.context.API.SearchMetrics(form.query, form.offset+form.limit+1)
+	if err != nil {
+		errorResponse(writer, http.StatusInternalServerError, err)
+		return
+	}
+	more := len(metrics) > form.offset+form.limit
+	if form.offset < len(metrics) {
+		metrics = metrics[form.offset:]
+	} else {
+		metrics = metrics[:0]
+	}
+	if len(metrics) > form.limit {
+		metrics = metrics[:form.limit]
+	}
+	response := response{
+		Body: metrics,
+		Metadata: map[string]interface{}{
+			"offset": form.offset,
+			"limit":  form.limit,
+			"more":   more,
+		},
+	}
+	bodyResponse(writer, response)
+}
+
 func (q queryHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
 	err := request.ParseForm()
 	if err != nil {
@@ -150,8 +236,9 @@ func (q queryHandler) ServeHTTP(writer http.ResponseWriter, request *http.Reques
 		return
 	}
 	response := response{
-		Body: result,
-		Name: cmd.Name(),
+		Body:     result.Body,
+		Metadata: result.Metadata,
+		Name:     cmd.Name(),
 	}
 	if parsedForm.profile {
 		response.Profile = convertProfile(profiler)
@@ -184,6 +271,10 @@ func NewMux(config Config, context query.ExecutionContext, hook Hook) *http.Serv
 		context: context,
 		hook:    hook,
 	})
+	httpMux.Handle("/search", searchHandler{
+		context: context,
+		hook:    hook,
+	})
 	staticPath := "/static/"
 	httpMux.Handle(staticPath, staticHandler{StaticPath: staticPath, Directory: config.StaticDir})
 	return httpMux